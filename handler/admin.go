@@ -0,0 +1,18 @@
+package handler
+
+import "net/http"
+
+// AdminBreakers is a handler to show the states of the circuit breakers.
+func AdminBreakers(env Env) Handler {
+	return func(w ResultWriter, _ *http.Request) error {
+		for name, b := range env.Breakers() {
+			x := b.Stats()
+			w.Body().Set(name, map[string]interface{}{
+				"state":    x.State.String(),
+				"requests": x.Requests,
+				"failures": x.Failures,
+			})
+		}
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/pb"
+)
+
+// AggregateHandler calls gateways and returns the response built from their responses.
+// gateways are the handlers of the calls of agg in order.
+func AggregateHandler(agg *pb.Action_Aggregate, gateways []Handler) Handler {
+	const tag = "[aggregate]"
+	var (
+		calls = agg.GetCalls()
+		index = make(map[string]int, len(calls))
+		ret   Handler
+	)
+	for i, x := range calls {
+		index[x.GetName()] = i
+	}
+	if agg.GetReturn() != nil {
+		ret = ReturnHandler(agg.GetReturn())
+	}
+	return func(w ResultWriter, r *http.Request) error {
+		// the calls read their own copies of the original body
+		requestBody, err := io.ReadAll(r.Body)
+		if err != nil {
+			return errors.Wrapf(err, errors.InvalidArgument, "%s read body", tag)
+		}
+		var (
+			c    = FromContext(r.Context())
+			done = make([]chan struct{}, len(calls))
+			errs = make([]error, len(calls))
+			// statuses of the failed calls
+			statuses = make([]int, len(calls))
+			wg       sync.WaitGroup
+		)
+		for i := range calls {
+			done[i] = make(chan struct{})
+		}
+		for i, x := range calls {
+			wg.Add(1)
+			go func(i int, x *pb.Action_Aggregate_Call) {
+				defer wg.Done()
+				defer close(done[i])
+				// net/http does not recover the panics of this goroutine
+				defer func() {
+					if p := recover(); p != nil {
+						errs[i] = errors.Newf(errors.UnknownError, "%s call %s panic %v", tag, x.GetName(), p)
+					}
+				}()
+				for _, name := range x.GetAfter() {
+					j := index[name]
+					<-done[j]
+					if errs[j] != nil {
+						errs[i] = errors.Newf(errors.Handler, "%s call %s is skipped because %s failed", tag, x.GetName(), name)
+						return
+					}
+				}
+				c.Log().Debug("%s call %s", tag, x.GetName())
+				rw := NewResultWriter()
+				r := r.Clone(r.Context())
+				r.Body = io.NopCloser(bytes.NewReader(requestBody))
+				if err := gateways[i](rw, r); err != nil {
+					statuses[i] = rw.Status().Get()
+					errs[i] = errors.Wrapf(err, errors.Handler, "%s call %s", tag, x.GetName())
+					return
+				}
+				body, ok := rw.Raw().Get()
+				if stream, isStream := rw.Stream().Get(); isStream {
+					b, err := io.ReadAll(stream)
+					stream.Close()
+					if err != nil {
+						errs[i] = errors.Wrapf(err, upstreamErrorCode(err), "%s call %s read stream", tag, x.GetName())
+						return
+					}
+					body, ok = b, true
+				}
+				if !ok {
+					b, err := json.Marshal(rw.Body().AsMap())
+					if err != nil {
+						errs[i] = errors.Wrapf(err, errors.Handler, "%s call %s marshal body", tag, x.GetName())
+						return
+					}
+					body = b
+				}
+				h := http.Header(rw.Headers().AsMap())
+				c.SetUpstream(x.GetName(), pb.NewUpstreamResponse(rw.Status().Get(), &h, body))
+			}(i, x)
+		}
+		wg.Wait()
+		for i, err := range errs {
+			if err != nil {
+				if statuses[i] != 0 {
+					w.Status().Set(statuses[i])
+				}
+				return err
+			}
+		}
+		if ret != nil {
+			return ret(w, r)
+		}
+		// the bodies of the calls by name
+		for name, x := range c.Upstreams() {
+			var v interface{}
+			if err := json.Unmarshal(x.Body(), &v); err != nil {
+				v = string(x.Body())
+			}
+			w.Body().Set(name, v)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// callLog records the order of the calls.
+type callLog struct {
+	sync.Mutex
+	names []string
+}
+
+func (s *callLog) add(name string) {
+	s.Lock()
+	defer s.Unlock()
+	s.names = append(s.names, name)
+}
+
+func (s *callLog) index(name string) int {
+	for i, x := range s.names {
+		if x == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestAggregateHandler(t *testing.T) {
+	ok := func(name string) func(*callLog) handler.Handler {
+		return func(l *callLog) handler.Handler {
+			return func(w handler.ResultWriter, _ *http.Request) error {
+				l.add(name)
+				w.Status().Set(http.StatusOK)
+				w.Raw().Set([]byte(`{"name":"` + name + `"}`))
+				return nil
+			}
+		}
+	}
+	fail := func(status int, code errors.Code) func(*callLog) handler.Handler {
+		return func(l *callLog) handler.Handler {
+			return func(w handler.ResultWriter, _ *http.Request) error {
+				l.add("fail")
+				if status != 0 {
+					w.Status().Set(status)
+				}
+				return errors.New(code, "failed")
+			}
+		}
+	}
+	panics := func(l *callLog) handler.Handler {
+		return func(handler.ResultWriter, *http.Request) error {
+			l.add("panic")
+			panic("boom")
+		}
+	}
+
+	for _, tc := range []*struct {
+		title    string
+		config   string
+		gateways []func(*callLog) handler.Handler
+		status   int
+		body     map[string]interface{}
+		// pairs of the calls that must be called in order
+		order [][2]string
+		// calls that must not be called
+		skipped []string
+	}{
+		{
+			title:  "bodies by name",
+			config: `{"calls":[{"name":"a"},{"name":"b"}]}`,
+			gateways: []func(*callLog) handler.Handler{
+				ok("a"), ok("b"),
+			},
+			status: http.StatusOK,
+			body: map[string]interface{}{
+				"a": map[string]interface{}{"name": "a"},
+				"b": map[string]interface{}{"name": "b"},
+			},
+		},
+		{
+			title:  "after",
+			config: `{"calls":[{"name":"c","after":["b"]},{"name":"b","after":["a"]},{"name":"a"}]}`,
+			gateways: []func(*callLog) handler.Handler{
+				ok("c"), ok("b"), ok("a"),
+			},
+			status: http.StatusOK,
+			body: map[string]interface{}{
+				"a": map[string]interface{}{"name": "a"},
+				"b": map[string]interface{}{"name": "b"},
+				"c": map[string]interface{}{"name": "c"},
+			},
+			order: [][2]string{{"a", "b"}, {"b", "c"}},
+		},
+		{
+			title:  "return",
+			config: `{"calls":[{"name":"a"}],"return":{"templates":[{"value":{"m":{"values":{"x":{"upstream":{"name":"a","keys":["name"]}}}}}}]}}`,
+			gateways: []func(*callLog) handler.Handler{
+				ok("a"),
+			},
+			status: http.StatusOK,
+			body: map[string]interface{}{
+				"x": "a",
+			},
+		},
+		{
+			title:  "status of the failed upstream",
+			config: `{"calls":[{"name":"a"},{"name":"b","after":["a"]}]}`,
+			gateways: []func(*callLog) handler.Handler{
+				fail(http.StatusServiceUnavailable, errors.UpstreamConnection), ok("b"),
+			},
+			status:  http.StatusServiceUnavailable,
+			skipped: []string{"b"},
+		},
+		{
+			title:  "default status of the failed upstream",
+			config: `{"calls":[{"name":"a"}]}`,
+			gateways: []func(*callLog) handler.Handler{
+				fail(0, errors.UpstreamTimeout),
+			},
+			status: http.StatusGatewayTimeout,
+		},
+		{
+			title:  "panic",
+			config: `{"calls":[{"name":"a"},{"name":"b","after":["a"]}]}`,
+			gateways: []func(*callLog) handler.Handler{
+				panics, ok("b"),
+			},
+			status:  http.StatusInternalServerError,
+			skipped: []string{"b"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var agg pb.Action_Aggregate
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.config), &agg))
+			var (
+				l        callLog
+				gateways = make([]handler.Handler, len(tc.gateways))
+			)
+			for i, g := range tc.gateways {
+				gateways[i] = g(&l)
+			}
+			w := httptest.NewRecorder()
+			handler.AggregateHandler(&agg, gateways).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			assert.Equal(t, tc.status, w.Code)
+			var got map[string]interface{}
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+			if tc.body != nil {
+				assert.Equal(t, tc.body, got)
+			} else {
+				assert.Equal(t, "error", got["result"])
+			}
+			for _, x := range tc.order {
+				assert.NotEqual(t, -1, l.index(x[0]), "%s is called", x[0])
+				assert.Less(t, l.index(x[0]), l.index(x[1]), "%s before %s", x[0], x[1])
+			}
+			for _, x := range tc.skipped {
+				assert.Equal(t, -1, l.index(x), "%s is skipped", x)
+			}
+		})
+	}
+}
+
+func TestAggregateHandlerRequestBody(t *testing.T) {
+	var agg pb.Action_Aggregate
+	assert.Nil(t, protojson.Unmarshal([]byte(`{"calls":[{"name":"a"},{"name":"b"},{"name":"c"}]}`), &agg))
+	// each call reads the whole body
+	echo := func(w handler.ResultWriter, r *http.Request) error {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		w.Raw().Set(b)
+		return nil
+	}
+	h := handler.AggregateHandler(&agg, []handler.Handler{echo, echo, echo})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[1,2]`)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var got map[string]interface{}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+	want := []interface{}{float64(1), float64(2)}
+	assert.Equal(t, map[string]interface{}{"a": want, "b": want, "c": want}, got)
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/cache"
+	"github.com/berquerant/jsonhttp/internal/util"
+	"github.com/berquerant/jsonhttp/pb"
+)
+
+// cachedResponse is a response from the upstream stored in the cache.
+type cachedResponse struct {
+	url    string
+	status int
+	header http.Header
+	body   []byte
+}
+
+type responseCache struct {
+	c     *pb.Cache
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func newResponseCache(c *pb.Cache) *responseCache {
+	size := int(c.GetSize())
+	if size == 0 {
+		size = 1000
+	}
+	return &responseCache{
+		c:     c,
+		cache: cache.New(size, nil),
+		ttl:   millis(c.GetTtl(), time.Minute),
+	}
+}
+
+// key returns the key of the request.
+func (s *responseCache) key(r *http.Request, src pb.TemplateSource, b pb.TemplateValueBuilder) (string, error) {
+	if s.c.GetKey() != nil {
+		x, err := b.Build(s.c.GetKey(), src)
+		if err != nil {
+			return "", err
+		}
+		if v, ok := x.GetValue().(*pb.Value_S); ok {
+			return v.S, nil
+		}
+		return util.JSON(x), nil
+	}
+	xs := []string{r.Method, r.URL.String()}
+	for _, h := range s.c.GetHeaders() {
+		xs = append(xs, strings.Join(r.Header.Values(h), ","))
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	default:
+		// the responses depend on the bodies
+		sum := sha256.Sum256(FromContext(r.Context()).Body())
+		xs = append(xs, hex.EncodeToString(sum[:]))
+	}
+	return strings.Join(xs, "\n"), nil
+}
+
+func (s *responseCache) requestControl(r *http.Request) cache.Control {
+	if !s.c.GetCacheControl() {
+		return cache.Control{}
+	}
+	return cache.ParseControl(r.Header.Values("Cache-Control"))
+}
+
+func (s *responseCache) get(key string, r *http.Request) (*cachedResponse, bool) {
+	d := s.requestControl(r)
+	if d.Has("no-cache") || d.Has("no-store") {
+		return nil, false
+	}
+	x, ok := s.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return x.(*cachedResponse), true
+}
+
+func (s *responseCache) set(key string, r *http.Request, u string, res *http.Response, body []byte) {
+	if !s.cacheable(res.StatusCode) || s.requestControl(r).Has("no-store") {
+		return
+	}
+	ttl := s.ttl
+	if s.c.GetCacheControl() {
+		d := cache.ParseControl(res.Header.Values("Cache-Control"))
+		if d.Has("no-store") || d.Has("no-cache") || d.Has("private") {
+			return
+		}
+		if x, ok := d.MaxAge(); ok {
+			ttl = x
+		}
+	}
+	if ttl <= 0 {
+		return
+	}
+	s.cache.Set(key, &cachedResponse{
+		url:    u,
+		status: res.StatusCode,
+		header: res.Header.Clone(),
+		body:   body,
+	}, ttl)
+}
+
+func (s *responseCache) cacheable(status int) bool {
+	xs := s.c.GetStatuses()
+	if len(xs) == 0 {
+		return status == http.StatusOK
+	}
+	for _, x := range xs {
+		if int(x) == status {
+			return true
+		}
+	}
+	return false
+}
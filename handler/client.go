@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/breaker"
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/pb"
+)
+
+// Env is the resources shared by the handlers of a server.
+type Env interface {
+	// Client returns the http client of the settings, the shared client if c is nil.
+	Client(c *pb.Client) (*http.Client, error)
+	// Breaker returns a new circuit breaker and registers it,
+	// returns an error if the name is already registered.
+	Breaker(c *pb.CircuitBreaker) (breaker.Breaker, error)
+	// Breakers returns the registered circuit breakers by name.
+	Breakers() map[string]breaker.Breaker
+	// Recorder returns the recorder of the file, shared by the gateways recording into the same file.
+	Recorder(file string) (Recorder, error)
+}
+
+func NewEnv(client *http.Client) Env {
+	return &env{
+		client:    client,
+		breakers:  map[string]breaker.Breaker{},
+		recorders: map[string]Recorder{},
+	}
+}
+
+type env struct {
+	client    *http.Client
+	breakers  map[string]breaker.Breaker
+	recorders map[string]Recorder
+	// number of the breakers without name
+	unnamed int
+}
+
+func (s *env) Breaker(c *pb.CircuitBreaker) (breaker.Breaker, error) {
+	name := c.GetName()
+	if name == "" {
+		// names starting with # are reserved
+		name = fmt.Sprintf("#%d", s.unnamed)
+		s.unnamed++
+	}
+	if _, ok := s.breakers[name]; ok {
+		return nil, errors.Newf(errors.InvalidSettings, "duplicated circuit breaker %s", name)
+	}
+	b := breaker.New(&breaker.Config{
+		FailureRate:  c.GetFailureRate(),
+		MinRequests:  int(c.GetMinRequests()),
+		Window:       time.Duration(c.GetWindow()) * time.Millisecond,
+		OpenDuration: time.Duration(c.GetOpenDuration()) * time.Millisecond,
+		Probes:       int(c.GetProbes()),
+	})
+	s.breakers[name] = b
+	return b, nil
+}
+
+func (s *env) Breakers() map[string]breaker.Breaker { return s.breakers }
+
+func (s *env) Recorder(file string) (Recorder, error) {
+	if r, ok := s.recorders[file]; ok {
+		return r, nil
+	}
+	r, err := NewRecorder(file)
+	if err != nil {
+		return nil, err
+	}
+	s.recorders[file] = r
+	return r, nil
+}
+
+func (s *env) Client(c *pb.Client) (*http.Client, error) {
+	if c == nil {
+		return s.client, nil
+	}
+	return NewHTTPClient(c)
+}
+
+func millis(v int32, d time.Duration) time.Duration {
+	if v == 0 {
+		return d
+	}
+	return time.Duration(v) * time.Millisecond
+}
+
+// NewHTTPClient returns a new http client with its own connection pool,
+// the client does not follow redirects.
+func NewHTTPClient(c *pb.Client) (*http.Client, error) {
+	dialer := &net.Dialer{
+		Timeout:   millis(c.GetDialTimeout(), 30*time.Second),
+		KeepAlive: millis(c.GetKeepAlive(), 30*time.Second),
+	}
+	t := &http.Transport{
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     !c.GetDisableHttp2(),
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   int(c.GetMaxIdleConnsPerHost()),
+		MaxConnsPerHost:       int(c.GetMaxConnsPerHost()),
+		IdleConnTimeout:       millis(c.GetIdleConnTimeout(), 90*time.Second),
+		DisableKeepAlives:     c.GetDisableKeepAlives(),
+		TLSHandshakeTimeout:   millis(c.GetTlsHandshakeTimeout(), 10*time.Second),
+		ResponseHeaderTimeout: millis(c.GetResponseHeaderTimeout(), 0),
+		ExpectContinueTimeout: time.Second,
+	}
+	if x := c.GetMaxIdleConns(); x != 0 {
+		t.MaxIdleConns = int(x)
+	}
+	if c.GetDisableHttp2() {
+		t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	switch {
+	case c.GetNoProxy():
+	case c.GetProxy() != "":
+		u, err := url.Parse(c.GetProxy())
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidSettings, "parse proxy %s", c.GetProxy())
+		}
+		t.Proxy = http.ProxyURL(u)
+	default:
+		t.Proxy = http.ProxyFromEnvironment
+	}
+
+	if c.GetCaFile() != "" || c.GetInsecureSkipVerify() {
+		t.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: c.GetInsecureSkipVerify(),
+		}
+	}
+	if c.GetCaFile() != "" {
+		b, err := os.ReadFile(c.GetCaFile())
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidSettings, "read ca file %s", c.GetCaFile())
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, errors.Newf(errors.InvalidSettings, "no certificates in ca file %s", c.GetCaFile())
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	return &http.Client{
+		Transport: t,
+		// the caller of the gateway follows the redirects
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
@@ -0,0 +1,193 @@
+package handler_test
+
+import (
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newClientSettings(t *testing.T, config string) *pb.Client {
+	var c pb.Client
+	assert.Nil(t, protojson.Unmarshal([]byte(config), &c))
+	return &c
+}
+
+// writeCAFile writes the certificate of the tls server as a PEM file.
+func writeCAFile(t *testing.T, s *httptest.Server) string {
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	b := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: s.Certificate().Raw,
+	})
+	assert.Nil(t, os.WriteFile(file, b, 0600))
+	return file
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Run("pool", func(t *testing.T) {
+		c, err := handler.NewHTTPClient(newClientSettings(t, `{"maxIdleConns":10,"maxIdleConnsPerHost":2,
+"maxConnsPerHost":3,"idleConnTimeout":1000,"disableKeepAlives":true}`))
+		assert.Nil(t, err)
+		tr := c.Transport.(*http.Transport)
+		assert.Equal(t, 10, tr.MaxIdleConns)
+		assert.Equal(t, 2, tr.MaxIdleConnsPerHost)
+		assert.Equal(t, 3, tr.MaxConnsPerHost)
+		assert.Equal(t, time.Second, tr.IdleConnTimeout)
+		assert.True(t, tr.DisableKeepAlives)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		c, err := handler.NewHTTPClient(nil)
+		assert.Nil(t, err)
+		tr := c.Transport.(*http.Transport)
+		assert.Equal(t, 100, tr.MaxIdleConns)
+		assert.Equal(t, 90*time.Second, tr.IdleConnTimeout)
+		assert.Equal(t, 10*time.Second, tr.TLSHandshakeTimeout)
+		assert.Equal(t, time.Duration(0), tr.ResponseHeaderTimeout)
+		assert.NotNil(t, tr.Proxy)
+	})
+
+	t.Run("response header timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer s.Close()
+		defer close(release)
+		c, err := handler.NewHTTPClient(newClientSettings(t, `{"responseHeaderTimeout":50}`))
+		assert.Nil(t, err)
+		_, err = c.Get(s.URL)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "timeout awaiting response headers")
+		}
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		var got string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.RequestURI
+		}))
+		defer proxy.Close()
+		c, err := handler.NewHTTPClient(newClientSettings(t, `{"proxy":"`+proxy.URL+`"}`))
+		assert.Nil(t, err)
+		res, err := c.Get("http://upstream.invalid/path?q=1")
+		assert.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, "http://upstream.invalid/path?q=1", got)
+	})
+
+	t.Run("no proxy", func(t *testing.T) {
+		c, err := handler.NewHTTPClient(newClientSettings(t, `{"noProxy":true,"proxy":"http://127.0.0.1:1"}`))
+		assert.Nil(t, err)
+		assert.Nil(t, c.Transport.(*http.Transport).Proxy)
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/moved", http.StatusFound)
+		}))
+		defer s.Close()
+		c, err := handler.NewHTTPClient(nil)
+		assert.Nil(t, err)
+		res, err := c.Get(s.URL)
+		assert.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusFound, res.StatusCode)
+		assert.Equal(t, "/moved", res.Header.Get("Location"))
+	})
+
+	t.Run("tls", func(t *testing.T) {
+		s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, r.Proto)
+		}))
+		s.EnableHTTP2 = true
+		s.StartTLS()
+		defer s.Close()
+		caFile := writeCAFile(t, s)
+
+		for _, tc := range []*struct {
+			title     string
+			config    string
+			wantErr   bool
+			wantProto string
+		}{
+			{
+				title:   "unknown authority",
+				config:  `{}`,
+				wantErr: true,
+			},
+			{
+				title:     "ca file",
+				config:    `{"caFile":"` + caFile + `"}`,
+				wantProto: "HTTP/2.0",
+			},
+			{
+				title:     "insecure skip verify",
+				config:    `{"insecureSkipVerify":true}`,
+				wantProto: "HTTP/2.0",
+			},
+			{
+				title:     "disable http2",
+				config:    `{"caFile":"` + caFile + `","disableHttp2":true}`,
+				wantProto: "HTTP/1.1",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				c, err := handler.NewHTTPClient(newClientSettings(t, tc.config))
+				assert.Nil(t, err)
+				res, err := c.Get(s.URL)
+				if tc.wantErr {
+					if assert.NotNil(t, err) {
+						assert.Contains(t, err.Error(), "certificate")
+					}
+					return
+				}
+				assert.Nil(t, err)
+				defer res.Body.Close()
+				b, err := io.ReadAll(res.Body)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.wantProto, string(b))
+			})
+		}
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "invalid.pem")
+		assert.Nil(t, os.WriteFile(invalid, []byte("not a certificate"), 0600))
+		for _, tc := range []*struct {
+			title  string
+			config string
+		}{
+			{
+				title:  "missing ca file",
+				config: `{"caFile":"` + filepath.Join(t.TempDir(), "missing.pem") + `"}`,
+			},
+			{
+				title:  "invalid ca file",
+				config: `{"caFile":"` + invalid + `"}`,
+			},
+			{
+				title:  "invalid proxy",
+				config: `{"proxy":"://"}`,
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				_, err := handler.NewHTTPClient(newClientSettings(t, tc.config))
+				e, ok := errors.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, errors.InvalidSettings, e.Code())
+			})
+		}
+	})
+}
@@ -3,15 +3,31 @@ package handler
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/berquerant/jsonhttp/internal/logger"
+	"github.com/berquerant/jsonhttp/pb"
 	"github.com/google/uuid"
 )
 
 type ctxKey string
 
-const ctxKeyValue ctxKey = "ctxKeyValue"
+const (
+	ctxKeyValue      ctxKey = "ctxKeyValue"
+	ctxKeyPathParams ctxKey = "ctxKeyPathParams"
+)
+
+// WithPathParams returns a context with the path parameters of the handler.
+func WithPathParams(ctx context.Context, params map[string]string) context.Context {
+	return context.WithValue(ctx, ctxKeyPathParams, params)
+}
+
+// PathParams returns the path parameters of the handler, nil if not set.
+func PathParams(ctx context.Context) map[string]string {
+	x, _ := ctx.Value(ctxKeyPathParams).(map[string]string)
+	return x
+}
 
 // Context is additional information for request scope.
 type Context interface {
@@ -23,6 +39,10 @@ type Context interface {
 	// Body returns the body of the request.
 	Body() []byte
 	WithContext(ctx context.Context) context.Context
+	// SetUpstream stores the response of the call of Aggregate.
+	SetUpstream(name string, res pb.UpstreamResponse)
+	// Upstreams returns the stored responses by name.
+	Upstreams() map[string]pb.UpstreamResponse
 }
 
 func FromContext(ctx context.Context) Context {
@@ -44,6 +64,9 @@ type contextImpl struct {
 	since  time.Time
 	logger logger.Logger
 	body   []byte
+
+	mux       sync.RWMutex
+	upstreams map[string]pb.UpstreamResponse
 }
 
 func (s *contextImpl) ID() string         { return s.id }
@@ -53,3 +76,22 @@ func (s *contextImpl) Body() []byte       { return s.body }
 func (s *contextImpl) WithContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, ctxKeyValue, s)
 }
+
+func (s *contextImpl) SetUpstream(name string, res pb.UpstreamResponse) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.upstreams == nil {
+		s.upstreams = map[string]pb.UpstreamResponse{}
+	}
+	s.upstreams[name] = res
+}
+
+func (s *contextImpl) Upstreams() map[string]pb.UpstreamResponse {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	d := make(map[string]pb.UpstreamResponse, len(s.upstreams))
+	for k, v := range s.upstreams {
+		d[k] = v
+	}
+	return d
+}
@@ -4,97 +4,191 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/berquerant/jsonhttp/internal/balancer"
+	"github.com/berquerant/jsonhttp/internal/breaker"
 	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/retry"
 	"github.com/berquerant/jsonhttp/internal/util"
 	"github.com/berquerant/jsonhttp/pb"
 )
 
-// GatewayHandler wraps a request to other url.
-func GatewayHandler(gw *pb.Action_Gateway) Handler {
+// GatewayHandler wraps a request to other url by client.
+// cb is the circuit breaker, nil means no breaker.
+// rec records the exchanges, nil means no recording.
+func GatewayHandler(gw *pb.Action_Gateway, client *http.Client, cb breaker.Breaker, rec Recorder) Handler {
 	const tag = "[gateway]"
+	var lb balancer.Balancer
+	if ups := gw.GetUpstreams(); ups != nil {
+		weights := make([]int, len(ups.GetTargets()))
+		for i, t := range ups.GetTargets() {
+			weights[i] = int(t.GetWeight())
+		}
+		lb = balancer.New(&balancer.Config{
+			Strategy:      balancer.Strategy(ups.GetStrategy()),
+			Weights:       weights,
+			MaxFails:      int(ups.GetMaxFails()),
+			EjectDuration: time.Duration(ups.GetEjectDuration()) * time.Millisecond,
+		})
+	}
+	var rc *responseCache
+	if x := gw.GetCache(); x != nil {
+		rc = newResponseCache(x)
+	}
+	// copy the response body to the client as it arrives
+	// unless the whole body is required
+	streaming := len(gw.GetResponseTemplates()) == 0 && rc == nil && !gw.GetMirror().GetDiff() && rec == nil
+	var recordBuilder pb.RecordBuilder
+	if rec != nil {
+		recordBuilder = pb.NewRecordBuilder(gw.GetRecord(), pb.NewValueConverter())
+	}
+	var fallback Handler
+	if x := gw.GetCircuitBreaker().GetFallback(); x != nil {
+		fallback = ReturnHandler(x)
+	}
 	return func(w ResultWriter, r *http.Request) error {
 		var (
 			c                    = FromContext(r.Context())
+			src                  = NewRequestTemplateSource(r)
 			templateValueBuilder = NewTemplateValueBuilder()
+			report               = func(breaker.Result) {}
 			err                  error
 		)
+		// not counted unless the request is sent
+		defer func() { report(breaker.Ignored) }()
+		// allow returns false if the circuit breaker rejects the request to the upstream
+		allow := func() bool {
+			if cb == nil {
+				return true
+			}
+			x, ok := cb.Allow()
+			if ok {
+				report = x
+			}
+			return ok
+		}
+		// rejected responds instead of the upstream while the circuit breaker is open
+		rejected := func() error {
+			c.Log().Warn("%s circuit breaker is open", tag)
+			if fallback != nil {
+				return fallback(w, r)
+			}
+			w.Status().Set(http.StatusServiceUnavailable)
+			w.Body().Set("error", "circuit breaker is open")
+			w.Body().Set("result", "error")
+			return nil
+		}
 		// build url
-		var u string
-		if u, err = func() (string, error) {
-			path, err := templateValueBuilder.Build(gw.GetPath(), pb.NewTemplateSource(r.URL, &r.Header, c.Body()))
+		var path string
+		if gw.GetPath() != nil {
+			x, err := templateValueBuilder.Build(gw.GetPath(), src)
 			if err != nil {
-				return "", err
+				return err
 			}
-			return path.GetS(), nil
-		}(); err != nil {
-			return err
+			path = x.GetS()
+		}
+		// nextURL selects the url to request and returns the function to report the result of the request
+		nextURL := func() (string, func(ok bool), error) {
+			var (
+				base = path
+				done = func(bool) {}
+			)
+			if lb != nil {
+				var i int
+				i, done = lb.Next()
+				base = gw.GetUpstreams().GetTargets()[i].GetUrl() + path
+			}
+			if !gw.GetPassthrough() {
+				return base, done, nil
+			}
+			u, err := passthroughURL(base, r.URL, gw.GetStripPrefix())
+			if err != nil {
+				done(false)
+				return "", nil, err
+			}
+			return u, done, nil
 		}
 		// build headers and body
 		var (
-			headers map[string]string
-			body    map[string]interface{}
+			headers     map[string][]string
+			body        map[string]interface{}
+			requestBody bytes.Buffer
+			// the original body streamed to the upstream, nil means requestBody
+			rawBody io.Reader
 		)
-		if headers, body, err = func() (headers map[string]string, body map[string]interface{}, err error) {
-			headers = map[string]string{}
-			body = map[string]interface{}{}
-			fromRaw := func() error {
-				if err := json.Unmarshal(c.Body(), &body); err != nil {
-					return errors.Wrapf(err, errors.Handler, "%s unmarshal body %s", tag, c.Body())
-				}
-				for k, v := range r.Header {
-					if len(v) > 0 {
-						headers[k] = v[0]
-					}
+		if len(gw.GetTemplates()) == 0 {
+			// forward raw body
+			headers = r.Header.Clone()
+			removeHopHeaders(headers)
+			if gw.GetRetry().GetMaxAttempts() > 1 || len(gw.GetMirror().GetUrls()) > 0 {
+				// the body is sent more than once
+				if _, err := requestBody.ReadFrom(r.Body); err != nil {
+					return errors.Wrapf(err, errors.InvalidArgument, "%s read body", tag)
 				}
-				return nil
+			} else {
+				rawBody = r.Body
 			}
-			writeTemplate := func() error {
-				b := NewTemplatesBuilder()
-				for i, t := range gw.GetTemplates() {
-					if err := b.Add(t, pb.NewTemplateSource(r.URL, &r.Header, c.Body())); err != nil {
-						c.Log().Error("%s template %d %s %v", tag, i, util.JSON(t), err)
-						return err
+		} else {
+			if headers, body, err = func() (headers map[string][]string, body map[string]interface{}, err error) {
+				headers = map[string][]string{}
+				body = map[string]interface{}{}
+				fromRaw := func() error {
+					if err := json.Unmarshal(c.Body(), &body); err != nil {
+						return errors.Wrapf(err, errors.Handler, "%s unmarshal body %s", tag, c.Body())
 					}
+					for k, v := range r.Header {
+						headers[k] = v
+					}
+					return nil
 				}
-				for k, v := range b.Headers() {
-					headers[k] = v
-				}
-				for k, v := range b.Body() {
-					body[k] = v
+				writeTemplate := func() error {
+					b := NewTemplatesBuilder()
+					for i, t := range gw.GetTemplates() {
+						if err := b.Add(t, src); err != nil {
+							c.Log().Error("%s template %d %s %v", tag, i, util.JSON(t), err)
+							return err
+						}
+					}
+					for k, v := range b.Headers() {
+						headers[http.CanonicalHeaderKey(k)] = v
+					}
+					for k, v := range b.Body() {
+						body[k] = v
+					}
+					if err := b.Edit(body, headers); err != nil {
+						c.Log().Error("%s edit templates %v", tag, err)
+						return err
+					}
+					return nil
 				}
-				return nil
-			}
 
-			switch gw.GetTemplateType() {
-			case pb.Action_APPEND:
-				if err = fromRaw(); err != nil {
-					return
-				}
-				if err = writeTemplate(); err != nil {
+				switch gw.GetTemplateType() {
+				case pb.Action_APPEND:
+					if err = fromRaw(); err != nil {
+						return
+					}
+					if err = writeTemplate(); err != nil {
+						return
+					}
 					return
-				}
-				return
-			case pb.Action_SELECT:
-				if len(gw.GetTemplates()) == 0 {
-					err = fromRaw()
+				case pb.Action_SELECT:
+					err = writeTemplate()
 					return
 				}
-				err = writeTemplate()
+				err = errors.Newf(errors.UnknownError, "unknown template type %s", gw.GetTemplateType())
 				return
+			}(); err != nil {
+				return err
 			}
-			err = errors.Newf(errors.UnknownError, "unknown template type %s", gw.GetTemplateType())
-			return
-		}(); err != nil {
-			return err
-		}
-
-		var requestBody bytes.Buffer
-		{
 			b, err := json.Marshal(body)
 			if err != nil {
 				return errors.Wrapf(err, errors.Handler, "%s marshal body %v", tag, body)
@@ -104,9 +198,11 @@ func GatewayHandler(gw *pb.Action_Gateway) Handler {
 			}
 		}
 		// set request timeout
-		ctx := r.Context()
+		ctx, cancelTimeout := r.Context(), context.CancelFunc(func() {})
+		// the streaming body takes over cancelTimeout
+		defer func() { cancelTimeout() }()
 		if gw.GetTimeout() != nil {
-			x, err := templateValueBuilder.Build(gw.GetTimeout(), pb.NewTemplateSource(r.URL, &r.Header, c.Body()))
+			x, err := templateValueBuilder.Build(gw.GetTimeout(), src)
 			if err != nil {
 				return errors.Wrapf(err, errors.Handler, "%s build timeout %s", tag, util.JSON(gw.GetTimeout()))
 			}
@@ -114,52 +210,227 @@ func GatewayHandler(gw *pb.Action_Gateway) Handler {
 			if err != nil {
 				return errors.Wrapf(err, errors.Handler, "%s type cast timeout %s", tag, util.JSON(x))
 			}
-			var cancel context.CancelFunc
-			ctx, cancel = context.WithTimeout(r.Context(), time.Duration(d)*time.Millisecond)
-			defer cancel()
+			timeoutCtx, cancel := context.WithTimeout(r.Context(), time.Duration(d)*time.Millisecond)
+			ctx, cancelTimeout = timeoutCtx, cancel
 		}
 		// build http request
-		var req *http.Request
-		if req, err = http.NewRequestWithContext(ctx, gw.GetMethodType().String(), u, &requestBody); err != nil {
-			return errors.Wrapf(err, errors.Handler, "%s build request", tag)
+		method := gw.GetMethodType().String()
+		if gw.GetPassthrough() || gw.GetMethodType() == pb.MethodType_ANY {
+			method = r.Method
 		}
-		for k, v := range headers {
-			req.Header.Set(k, v)
+		// doRequest reads the response body unless streaming, the body of the response is left open if streaming
+		doRequest := func(u string) (*http.Response, []byte, error) {
+			ctx, cancel := ctx, context.CancelFunc(func() {})
+			if x := gw.GetRetry().GetAttemptTimeout(); x > 0 {
+				attemptCtx, attemptCancel := context.WithTimeout(ctx, time.Duration(x)*time.Millisecond)
+				ctx, cancel = attemptCtx, attemptCancel
+			}
+			var reqBody io.Reader = bytes.NewReader(requestBody.Bytes())
+			if rawBody != nil {
+				reqBody = rawBody
+			}
+			req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+			if err != nil {
+				cancel()
+				return nil, nil, errors.Wrapf(err, errors.Handler, "%s build request", tag)
+			}
+			if rawBody != nil {
+				// unknown length if -1 or 0, the empty body is sent as no body
+				req.ContentLength = r.ContentLength
+			}
+			for k, vs := range headers {
+				for _, v := range vs {
+					req.Header.Add(k, v)
+				}
+			}
+			res, err := client.Do(req)
+			if err != nil {
+				cancel()
+				return nil, nil, errors.Wrapf(err, upstreamErrorCode(err), "%s do request", tag)
+			}
+			if streaming {
+				res.Body = &cancelReadCloser{
+					ReadCloser: res.Body,
+					cancel:     cancel,
+				}
+				return res, nil, nil
+			}
+			defer cancel()
+			defer res.Body.Close()
+			b, err := io.ReadAll(res.Body)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, upstreamErrorCode(err), "%s read response body", tag)
+			}
+			return res, b, nil
 		}
 		// do http request
-		c.Log().Info("%s request to %s", tag, u)
-		c.Log().Debug(`%s request with headers %s body "%s"`, tag, util.JSON(headers), requestBody)
-		var res *http.Response
-		if res, err = http.DefaultClient.Do(req); err != nil {
-			return errors.Wrapf(err, errors.Handler, "%s do request", tag)
+		c.Log().Debug(`%s request with headers %s body "%s"`, tag, util.JSON(headers), requestBody.Bytes())
+		var (
+			u            string
+			res          *http.Response
+			responseBody []byte
+			retryPolicy  = gw.GetRetry()
+			backoff      = retry.NewBackoff(
+				time.Duration(retryPolicy.GetBackoff())*time.Millisecond,
+				time.Duration(retryPolicy.GetMaxBackoff())*time.Millisecond,
+				retryPolicy.GetJitter(),
+				nil,
+			)
+			shouldRetry = func(res *http.Response, err error) bool {
+				if err != nil {
+					return retryPolicy.GetNetworkErrors() && ctx.Err() == nil
+				}
+				for _, x := range retryPolicy.GetStatuses() {
+					if int(x) == res.StatusCode {
+						return true
+					}
+				}
+				return false
+			}
+		)
+		// fetch requests to the upstream with retries
+		fetch := func() error {
+			reportMirror := func(*http.Response, []byte) {}
+			for attempt := 1; ; attempt++ {
+				var done func(ok bool)
+				if u, done, err = nextURL(); err != nil {
+					reportMirror(nil, nil)
+					return err
+				}
+				if attempt == 1 && len(gw.GetMirror().GetUrls()) > 0 {
+					reportMirror = sendMirrors(c, gw.GetMirror(), client, method, u, headers, requestBody.Bytes())
+				}
+				c.Log().Info("%s request to %s %s", tag, method, u)
+				res, responseBody, err = doRequest(u)
+				ok := err == nil && res.StatusCode < http.StatusInternalServerError
+				if streaming && err == nil {
+					// the upstream is outstanding until the stream is closed
+					res.Body = &cancelReadCloser{
+						ReadCloser: res.Body,
+						cancel:     func() { done(ok) },
+					}
+				} else {
+					done(ok)
+				}
+				if attempt >= int(retryPolicy.GetMaxAttempts()) || !shouldRetry(res, err) {
+					break
+				}
+				d := backoff.Duration(attempt)
+				if err != nil {
+					c.Log().Warn("%s attempt %d failed %v, retry after %s", tag, attempt, err, d)
+				} else {
+					c.Log().Warn("%s attempt %d got status %d, retry after %s", tag, attempt, res.StatusCode, d)
+				}
+				if serr := retry.Sleep(ctx, d); serr != nil {
+					if streaming && err == nil {
+						// the stream is not readable after the context is done
+						res.Body.Close()
+						res, err = nil, errors.Wrapf(serr, upstreamErrorCode(serr), "%s wait for retry", tag)
+					}
+					break
+				}
+				if streaming && err == nil {
+					res.Body.Close()
+				}
+			}
+			if err != nil || res.StatusCode >= http.StatusInternalServerError {
+				report(breaker.Failure)
+			} else {
+				report(breaker.Success)
+			}
+			reportMirror(res, responseBody)
+			return err
+		}
+		if rc == nil {
+			if !allow() {
+				return rejected()
+			}
+			if err := fetch(); err != nil {
+				setErrorStatus(w, gw.GetErrorStatus(), err)
+				return err
+			}
+		} else {
+			// the cached responses are served even if the circuit breaker is open
+			key, err := rc.key(r, src, templateValueBuilder)
+			if err != nil {
+				return errors.Wrapf(err, errors.Handler, "%s build cache key", tag)
+			}
+			if x, ok := rc.get(key, r); ok {
+				c.Log().Debug("%s cache hit %s", tag, key)
+				u = x.url
+				res = &http.Response{
+					StatusCode: x.status,
+					Header:     x.header.Clone(),
+				}
+				responseBody = x.body
+				w.Headers().Set("X-Cache", "HIT")
+			} else {
+				if !allow() {
+					return rejected()
+				}
+				if err := fetch(); err != nil {
+					setErrorStatus(w, gw.GetErrorStatus(), err)
+					return err
+				}
+				rc.set(key, r, u, res, responseBody)
+				w.Headers().Set("X-Cache", "MISS")
+			}
+		}
+		if rec != nil {
+			record := func() error {
+				h, err := recordBuilder.Build(r, c.Body(), pb.NewUpstreamResponse(res.StatusCode, &res.Header, responseBody))
+				if err != nil {
+					return err
+				}
+				return rec.Record(h)
+			}
+			if err := record(); err != nil {
+				c.Log().Warn("%s cannot record %v", tag, err)
+			}
 		}
-		defer res.Body.Close()
 		// build response
 		w.Status().Set(res.StatusCode)
-		responseBody, err := io.ReadAll(res.Body)
-		if err != nil {
-			return errors.Wrapf(err, errors.Handler, "%s read response body", tag)
-		}
 		c.Log().Debug(`%s got response status %d headers %s body "%s"`, tag, res.StatusCode, util.JSON(res.Header), responseBody)
 		writeTemplate := func() error {
 			ru, err := url.Parse(u)
 			if err != nil {
 				errors.Wrapf(err, errors.Handler, "%s parse request url %s", tag, u)
 			}
-			src := pb.NewTemplateSource(ru, &res.Header, responseBody)
+			resSrc := pb.NewTemplateSource(ru, &res.Header, responseBody)
 			builder := NewTemplatesBuilder()
 			for i, t := range gw.GetResponseTemplates() {
-				if err := builder.Add(t, src); err != nil {
+				if err := builder.Add(t, resSrc); err != nil {
 					c.Log().Error("%s response template %d %s %v", tag, i, util.JSON(t), err)
 					return err
 				}
 			}
-			for k, v := range builder.Headers() {
-				w.Headers().Set(k, v)
-			}
+			WriteHeaders(w.Headers(), builder.Headers())
 			for k, v := range builder.Body() {
 				w.Body().Set(k, v)
 			}
+			if err := builder.Edit(w.Body().AsMap(), w.Headers().AsMap()); err != nil {
+				c.Log().Error("%s edit response templates %v", tag, err)
+				return err
+			}
+			return nil
+		}
+		if streaming {
+			h := res.Header.Clone()
+			removeHopHeaders(h)
+			WriteHeaders(w.Headers(), h)
+			w.Stream().Set(&cancelReadCloser{
+				ReadCloser: res.Body,
+				cancel:     cancelTimeout,
+			})
+			cancelTimeout = func() {}
+			return nil
+		}
+		if len(gw.GetResponseTemplates()) == 0 {
+			// write raw body
+			h := res.Header.Clone()
+			removeHopHeaders(h)
+			WriteHeaders(w.Headers(), h)
+			w.Raw().Set(responseBody)
 			return nil
 		}
 		switch gw.GetResponseTemplateType() {
@@ -169,14 +440,126 @@ func GatewayHandler(gw *pb.Action_Gateway) Handler {
 			}
 			return writeTemplate()
 		case pb.Action_SELECT:
-			if len(gw.GetResponseTemplates()) == 0 {
-				if err := WriteResultFromSource(w, pb.NewTemplateSource(nil, &res.Header, responseBody)); err != nil {
-					return errors.Wrapf(err, errors.Handler, "%s write result %s", tag, responseBody)
-				}
-				return nil
-			}
 			return writeTemplate()
 		}
 		return errors.Newf(errors.UnknownError, "unknown response template type %s", gw.GetResponseTemplateType())
 	}
 }
+
+// passthroughURL appends the escaped path of the incoming url without prefix to base
+// and the raw query of the incoming url to the query of base.
+// The prefix is removed only if it ends at a segment boundary.
+func passthroughURL(base string, in *url.URL, prefix string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", errors.Wrapf(err, errors.InvalidValue, "parse base url %s", base)
+	}
+	rest := in.EscapedPath()
+	if p := strings.TrimSuffix(prefix, "/"); p != "" && (rest == p || strings.HasPrefix(rest, p+"/")) {
+		rest = strings.TrimPrefix(rest, p)
+	}
+	if rest = strings.TrimPrefix(rest, "/"); rest != "" {
+		escaped := strings.TrimSuffix(u.EscapedPath(), "/") + "/" + rest
+		path, err := url.PathUnescape(escaped)
+		if err != nil {
+			return "", errors.Wrapf(err, errors.InvalidValue, "unescape path %s", escaped)
+		}
+		u.Path = path
+		u.RawPath = escaped
+	}
+	if in.RawQuery != "" {
+		if u.RawQuery != "" {
+			u.RawQuery += "&"
+		}
+		u.RawQuery += in.RawQuery
+	}
+	return u.String(), nil
+}
+
+// upstreamErrorCode returns the code by the class of the failure of the request to the upstream.
+func upstreamErrorCode(err error) errors.Code {
+	var dnsErr *net.DNSError
+	if stderrors.As(err, &dnsErr) {
+		if dnsErr.IsTimeout {
+			return errors.UpstreamTimeout
+		}
+		return errors.UpstreamDNS
+	}
+	if stderrors.Is(err, context.DeadlineExceeded) {
+		return errors.UpstreamTimeout
+	}
+	var netErr net.Error
+	if stderrors.As(err, &netErr) && netErr.Timeout() {
+		return errors.UpstreamTimeout
+	}
+	var opErr *net.OpError
+	if stderrors.As(err, &opErr) && opErr.Op == "dial" {
+		return errors.UpstreamConnection
+	}
+	if stderrors.Is(err, syscall.ECONNREFUSED) || stderrors.Is(err, syscall.ECONNRESET) {
+		return errors.UpstreamConnection
+	}
+	return errors.UpstreamError
+}
+
+// setErrorStatus sets the status of the upstream failure by the settings.
+func setErrorStatus(w ResultWriter, s *pb.ErrorStatus, err error) {
+	e, ok := errors.As(err)
+	if !ok {
+		return
+	}
+	u, ok := e.Find(errors.Code.IsUpstream)
+	if !ok {
+		return
+	}
+	var status int32
+	switch u.Code() {
+	case errors.UpstreamTimeout:
+		status = s.GetTimeout()
+	case errors.UpstreamConnection:
+		status = s.GetConnection()
+	case errors.UpstreamDNS:
+		status = s.GetDns()
+	default:
+		status = s.GetOther()
+	}
+	if status != 0 {
+		w.Status().Set(int(status))
+	}
+}
+
+// cancelReadCloser calls cancel once after closing the body.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+	once   sync.Once
+}
+
+func (s *cancelReadCloser) Close() error {
+	defer s.once.Do(s.cancel)
+	return s.ReadCloser.Close()
+}
+
+// hopHeaders are the hop-by-hop headers not forwarded.
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+func removeHopHeaders(h http.Header) {
+	for _, k := range h.Values("Connection") {
+		for _, x := range strings.Split(k, ",") {
+			h.Del(strings.TrimSpace(x))
+		}
+	}
+	for _, k := range hopHeaders {
+		h.Del(k)
+	}
+}
@@ -0,0 +1,701 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/internal/breaker"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newGateway(t *testing.T, config string) *pb.Action_Gateway {
+	var gw pb.Action_Gateway
+	assert.Nil(t, protojson.Unmarshal([]byte(config), &gw))
+	return &gw
+}
+
+func TestGatewayPassthrough(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"uri": r.RequestURI,
+		})
+	}))
+	defer upstream.Close()
+
+	for _, tc := range []*struct {
+		title  string
+		base   string
+		prefix string
+		target string
+		want   string
+	}{
+		{
+			title:  "path and query",
+			base:   "/base",
+			target: "/a/b?x=1",
+			want:   "/base/a/b?x=1",
+		},
+		{
+			title:  "strip prefix",
+			base:   "/base",
+			prefix: "/api",
+			target: "/api/a",
+			want:   "/base/a",
+		},
+		{
+			title:  "strip prefix only",
+			base:   "/base",
+			prefix: "/api/",
+			target: "/api",
+			want:   "/base",
+		},
+		{
+			title:  "prefix not at segment boundary",
+			base:   "/base",
+			prefix: "/api",
+			target: "/apix/y",
+			want:   "/base/apix/y",
+		},
+		{
+			title:  "encoded path",
+			base:   "/base",
+			target: "/files/a%2Fb%20c",
+			want:   "/base/files/a%2Fb%20c",
+		},
+		{
+			title:  "repeated query keys in order",
+			base:   "/base?k=0",
+			target: "/a?z=1&a=2&z=3&e=%2B",
+			want:   "/base/a?k=0&z=1&a=2&z=3&e=%2B",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"passthrough":true,"stripPrefix":%q}`,
+				upstream.URL+tc.base, tc.prefix))
+			h := handler.GatewayHandler(gw, upstream.Client(), nil, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.target, nil))
+			assert.Equal(t, http.StatusOK, w.Code)
+			var got map[string]string
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, tc.want, got["uri"])
+		})
+	}
+}
+
+func TestGatewayCache(t *testing.T) {
+	var count int
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.Copy(w, r.Body)
+	}))
+	defer upstream.Close()
+
+	for _, tc := range []*struct {
+		title  string
+		method string
+		bodies []string
+		want   []string
+	}{
+		{
+			title:  "get",
+			method: http.MethodGet,
+			bodies: []string{"", ""},
+			want:   []string{"MISS", "HIT"},
+		},
+		{
+			title:  "post with different bodies",
+			method: http.MethodPost,
+			bodies: []string{`{"a":1}`, `{"a":2}`},
+			want:   []string{"MISS", "MISS"},
+		},
+		{
+			title:  "post with the same body",
+			method: http.MethodPost,
+			bodies: []string{`{"a":1}`, `{"a":1}`},
+			want:   []string{"MISS", "HIT"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			count = 0
+			gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"methodType":"ANY","cache":{}}`, upstream.URL))
+			h := handler.GatewayHandler(gw, upstream.Client(), nil, nil)
+			var misses int
+			for i, body := range tc.bodies {
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, httptest.NewRequest(tc.method, "/", strings.NewReader(body)))
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, tc.want[i], w.Header().Get("X-Cache"), "request %d", i)
+				if tc.want[i] == "MISS" {
+					misses++
+				}
+				if body != "" {
+					assert.Equal(t, body, w.Body.String(), "request %d", i)
+				}
+			}
+			assert.Equal(t, misses, count)
+		})
+	}
+}
+
+func TestGatewayStreamRetryInterrupted(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = io.WriteString(w, `{"retry":true}`)
+	}))
+	defer upstream.Close()
+
+	// the timeout expires while waiting for the second attempt
+	gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"timeout":{"n":100},
+"retry":{"maxAttempts":3,"statuses":[503],"backoff":1000}}`, upstream.URL))
+	h := handler.GatewayHandler(gw, upstream.Client(), nil, nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	var got map[string]string
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, "UpstreamTimeout", got["code"])
+}
+
+func TestGatewayStreamLeastOutstanding(t *testing.T) {
+	var (
+		release  = make(chan struct{})
+		started  = make(chan struct{})
+		newServe = func(name string) *httptest.Server {
+			return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, name)
+				if r.URL.Path == "/slow" {
+					w.(http.Flusher).Flush()
+					close(started)
+					<-release
+				}
+			}))
+		}
+		a = newServe("a")
+		b = newServe("b")
+	)
+	defer a.Close()
+	defer b.Close()
+
+	gw := newGateway(t, fmt.Sprintf(`{"passthrough":true,"upstreams":{"strategy":"LEAST_OUTSTANDING",
+"targets":[{"url":%q},{"url":%q}]}}`, a.URL, b.URL))
+	h := handler.GatewayHandler(gw, http.DefaultClient, nil, nil)
+
+	slow := make(chan string)
+	go func() {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/slow", nil))
+		slow <- w.Body.String()
+	}()
+	<-started
+	// the slow target is outstanding while streaming
+	var fast []string
+	for i := 0; i < 4; i++ {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/fast", nil))
+		fast = append(fast, w.Body.String())
+	}
+	close(release)
+	s := <-slow
+	other := map[string]string{"a": "b", "b": "a"}[s]
+	assert.Equal(t, []string{other, other, other, other}, fast)
+}
+
+func TestGatewayRawBody(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"body":            base64.StdEncoding.EncodeToString(b),
+			"contentType":     r.Header.Get("Content-Type"),
+			"contentEncoding": r.Header.Get("Content-Encoding"),
+		})
+	}))
+	defer upstream.Close()
+
+	for _, tc := range []*struct {
+		title    string
+		body     string
+		chunked  bool
+		header   map[string]string
+		wantBody string
+	}{
+		{
+			title: "not json",
+			body:  "a=1&b=2",
+			header: map[string]string{
+				"Content-Type": "application/x-www-form-urlencoded",
+			},
+			wantBody: "a=1&b=2",
+		},
+		{
+			title: "json array",
+			body:  `[1,{"a":2}]`,
+			header: map[string]string{
+				"Content-Type": "application/json",
+			},
+			wantBody: `[1,{"a":2}]`,
+		},
+		{
+			title: "compressed",
+			body:  "\x1f\x8b\x08\x00\xff",
+			header: map[string]string{
+				"Content-Type":     "text/plain; charset=utf-8",
+				"Content-Encoding": "gzip",
+			},
+			wantBody: "\x1f\x8b\x08\x00\xff",
+		},
+		{
+			title:    "empty chunked",
+			chunked:  true,
+			wantBody: "",
+		},
+		{
+			title:    "empty",
+			wantBody: "",
+		},
+	} {
+		for _, config := range []string{
+			`{"path":{"s":%q},"methodType":"POST"}`,
+			// the body is buffered to retry
+			`{"path":{"s":%q},"methodType":"POST","retry":{"maxAttempts":2}}`,
+		} {
+			config := fmt.Sprintf(config, upstream.URL)
+			t.Run(tc.title+" "+config, func(t *testing.T) {
+				h := handler.GatewayHandler(newGateway(t, config), upstream.Client(), nil, nil)
+				r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+				if tc.chunked {
+					r.ContentLength = -1
+					r.TransferEncoding = []string{"chunked"}
+				}
+				for k, v := range tc.header {
+					r.Header.Set(k, v)
+				}
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, r)
+				assert.Equal(t, http.StatusOK, w.Code)
+				var got map[string]string
+				assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+				assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(tc.wantBody)), got["body"])
+				assert.Equal(t, tc.header["Content-Type"], got["contentType"])
+				assert.Equal(t, tc.header["Content-Encoding"], got["contentEncoding"])
+			})
+		}
+	}
+}
+
+func TestGatewayUpstreamError(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer slow.Close()
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer tlsServer.Close()
+	closed := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	closed.Close()
+	// the resolver always fails
+	dnsClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Resolver: &net.Resolver{
+					PreferGo: true,
+					Dial: func(context.Context, string, string) (net.Conn, error) {
+						return nil, fmt.Errorf("no dns server")
+					},
+				},
+			}).DialContext,
+		},
+	}
+
+	const errorStatus = `"errorStatus":{"timeout":503,"connection":500,"dns":404,"other":501}`
+	for _, tc := range []*struct {
+		title      string
+		url        string
+		client     *http.Client
+		config     string
+		wantStatus int
+		wantCode   string
+		wantCause  string
+	}{
+		{
+			title:      "timeout",
+			url:        slow.URL,
+			config:     `"timeout":{"n":50}`,
+			wantStatus: http.StatusGatewayTimeout,
+			wantCode:   "UpstreamTimeout",
+			wantCause:  "deadline exceeded",
+		},
+		{
+			title:      "timeout with error status",
+			url:        slow.URL,
+			config:     `"timeout":{"n":50},` + errorStatus,
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   "UpstreamTimeout",
+			wantCause:  "deadline exceeded",
+		},
+		{
+			title:      "connection refused",
+			url:        closed.URL,
+			wantStatus: http.StatusBadGateway,
+			wantCode:   "UpstreamConnection",
+			wantCause:  "connection refused",
+		},
+		{
+			title:      "connection refused with error status",
+			url:        closed.URL,
+			config:     errorStatus,
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "UpstreamConnection",
+			wantCause:  "connection refused",
+		},
+		{
+			title:      "dns",
+			url:        "http://upstream.invalid",
+			client:     dnsClient,
+			wantStatus: http.StatusBadGateway,
+			wantCode:   "UpstreamDNS",
+			wantCause:  "upstream.invalid",
+		},
+		{
+			title:      "dns with error status",
+			url:        "http://upstream.invalid",
+			client:     dnsClient,
+			config:     errorStatus,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "UpstreamDNS",
+			wantCause:  "upstream.invalid",
+		},
+		{
+			title:      "other",
+			url:        tlsServer.URL,
+			wantStatus: http.StatusBadGateway,
+			wantCode:   "UpstreamError",
+			wantCause:  "certificate",
+		},
+		{
+			title:      "other with error status",
+			url:        tlsServer.URL,
+			config:     errorStatus,
+			wantStatus: http.StatusNotImplemented,
+			wantCode:   "UpstreamError",
+			wantCause:  "certificate",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			config := fmt.Sprintf(`{"path":{"s":%q}`, tc.url)
+			if tc.config != "" {
+				config += "," + tc.config
+			}
+			client := tc.client
+			if client == nil {
+				client = http.DefaultClient
+			}
+			h := handler.GatewayHandler(newGateway(t, config+"}"), client, nil, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			assert.Equal(t, tc.wantStatus, w.Code)
+			var got map[string]string
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, "error", got["result"])
+			assert.Equal(t, tc.wantCode, got["code"])
+			assert.Contains(t, got["cause"], tc.wantCause)
+		})
+	}
+}
+
+func TestGatewayCacheCircuitBreakerOpen(t *testing.T) {
+	var (
+		count int
+		down  bool
+	)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if down {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = io.WriteString(w, `{"path":"`+r.URL.Path+`"}`)
+	}))
+	defer upstream.Close()
+
+	gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"passthrough":true,"cache":{},
+"circuitBreaker":{"failureRate":0.5,"minRequests":2,"openDuration":60000,"fallback":{"status":200,"raw":"fallback"}}}`,
+		upstream.URL))
+	cb := breaker.New(&breaker.Config{
+		FailureRate:  0.5,
+		MinRequests:  2,
+		OpenDuration: time.Minute,
+	})
+	h := handler.GatewayHandler(gw, upstream.Client(), cb, nil)
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	w := get("/cached")
+	assert.Equal(t, "MISS", w.Header().Get("X-Cache"))
+	assert.Equal(t, `{"path":"/cached"}`, w.Body.String())
+	// open the breaker
+	down = true
+	w = get("/failure")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, breaker.Open, cb.Stats().State)
+	assert.Equal(t, 2, count)
+
+	w = get("/cached")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "HIT", w.Header().Get("X-Cache"))
+	assert.Equal(t, `{"path":"/cached"}`, w.Body.String())
+	w = get("/other")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "fallback", w.Body.String())
+	assert.Equal(t, 2, count)
+}
+
+func TestGatewayMultiValuedHeaders(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Set-Cookie", "a=x")
+		w.Header().Add("Set-Cookie", "b=y; HttpOnly")
+		w.Header().Add("X-Multi", "1")
+		w.Header().Add("X-Multi", "2")
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	defer upstream.Close()
+
+	for _, config := range []string{
+		`{"path":{"s":%q},"passthrough":true}`,
+		// the whole body is read
+		`{"path":{"s":%q},"passthrough":true,"cache":{}}`,
+		`{"path":{"s":%q},"passthrough":true,"responseTemplateType":"APPEND",
+"responseTemplates":[{"type":"HEADER","value":{"m":{"values":{"X-Added":{"s":"1"}}}}}]}`,
+	} {
+		config := fmt.Sprintf(config, upstream.URL)
+		t.Run(config, func(t *testing.T) {
+			h := handler.GatewayHandler(newGateway(t, config), upstream.Client(), nil, nil)
+			// the second response is cached if cache is set
+			for i := 0; i < 2; i++ {
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, []string{"a=x", "b=y; HttpOnly"}, w.Header().Values("Set-Cookie"), "request %d", i)
+				assert.Equal(t, []string{"1", "2"}, w.Header().Values("X-Multi"), "request %d", i)
+			}
+		})
+	}
+}
+
+func TestGatewayUpstreams(t *testing.T) {
+	var (
+		mux   sync.Mutex
+		count map[string]int
+	)
+	newTarget := func(name string, status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mux.Lock()
+			count[name]++
+			mux.Unlock()
+			w.WriteHeader(status)
+			_, _ = io.WriteString(w, name+" "+r.URL.Path)
+		}))
+	}
+	var (
+		a    = newTarget("a", http.StatusOK)
+		b    = newTarget("b", http.StatusOK)
+		c    = newTarget("c", http.StatusOK)
+		down = newTarget("down", http.StatusInternalServerError)
+	)
+	defer a.Close()
+	defer b.Close()
+	defer c.Close()
+	defer down.Close()
+
+	for _, tc := range []*struct {
+		title     string
+		upstreams string
+		requests  int
+		want      map[string]int
+	}{
+		{
+			title:     "round robin",
+			upstreams: fmt.Sprintf(`{"targets":[{"url":%q},{"url":%q},{"url":%q}]}`, a.URL, b.URL, c.URL),
+			requests:  6,
+			want:      map[string]int{"a": 2, "b": 2, "c": 2},
+		},
+		{
+			title: "eject failed target",
+			upstreams: fmt.Sprintf(`{"targets":[{"url":%q},{"url":%q}],"maxFails":1,"ejectDuration":60000}`,
+				down.URL, a.URL),
+			requests: 4,
+			want:     map[string]int{"down": 1, "a": 3},
+		},
+		{
+			title: "weighted",
+			upstreams: fmt.Sprintf(`{"strategy":"WEIGHTED","targets":[{"url":%q,"weight":1},{"url":%q,"weight":1000000}]}`,
+				a.URL, b.URL),
+			requests: 4,
+			want:     map[string]int{"b": 4},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			count = map[string]int{}
+			gw := newGateway(t, fmt.Sprintf(`{"path":{"s":"/p"},"upstreams":%s}`, tc.upstreams))
+			h := handler.GatewayHandler(gw, http.DefaultClient, nil, nil)
+			for i := 0; i < tc.requests; i++ {
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+				assert.True(t, strings.HasSuffix(w.Body.String(), " /p"), w.Body.String())
+			}
+			assert.Equal(t, tc.want, count)
+		})
+	}
+}
+
+func TestGatewayCircuitBreaker(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer upstream.Close()
+
+	var action pb.Action
+	assert.Nil(t, protojson.Unmarshal([]byte(fmt.Sprintf(`{"gateway":{"path":{"s":%q},
+"circuitBreaker":{"name":"api","failureRate":0.5,"minRequests":2,"openDuration":60000,
+"fallback":{"status":200,"raw":"fallback"}}}}`, upstream.URL)), &action))
+	env := handler.NewEnv(upstream.Client())
+	h, err := handler.HandlerFromAction(&action, env)
+	assert.Nil(t, err)
+	admin := handler.AdminBreakers(env)
+	breakers := func() map[string]map[string]interface{} {
+		w := httptest.NewRecorder()
+		admin.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/breakers", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got map[string]map[string]interface{}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+		return got
+	}
+
+	assert.Equal(t, map[string]map[string]interface{}{
+		"api": {"state": "CLOSED", "requests": float64(0), "failures": float64(0)},
+	}, breakers())
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code, "request %d", i)
+	}
+	assert.Equal(t, "OPEN", breakers()["api"]["state"])
+	// the fallback is served instead of the upstream
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "fallback", w.Body.String())
+}
+
+func TestGatewayRecord(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") == "2" {
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, "not found")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Not-Recorded", "1")
+		_, _ = io.WriteString(w, `{"id":"`+r.URL.Query().Get("id")+`","lang":"`+r.Header.Get("Accept-Language")+`"}`)
+	}))
+	defer upstream.Close()
+
+	file := filepath.Join(t.TempDir(), "record.json")
+	rec, err := handler.NewRecorder(file)
+	assert.Nil(t, err)
+	gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"passthrough":true,
+"record":{"file":%q,"headers":["Accept-Language"],"queries":["id"]}}`, upstream.URL, file))
+	h := handler.GatewayHandler(gw, upstream.Client(), nil, rec)
+
+	type exchange struct {
+		target string
+		lang   string
+		status int
+		body   string
+	}
+	exchanges := []*exchange{
+		{
+			target: "/users?id=1",
+			lang:   "ja",
+			status: http.StatusOK,
+			body:   `{"id":"1","lang":"ja"}`,
+		},
+		{
+			target: "/users?id=2",
+			lang:   "en",
+			status: http.StatusNotFound,
+			body:   "not found",
+		},
+	}
+	newRequest := func(x *exchange) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, x.target, nil)
+		r.Header.Set("Accept-Language", x.lang)
+		return r
+	}
+	for _, x := range exchanges {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest(x))
+		assert.Equal(t, x.status, w.Code)
+		assert.Equal(t, x.body, w.Body.String())
+	}
+
+	b, err := os.ReadFile(file)
+	assert.Nil(t, err)
+	var recorded pb.Server
+	assert.Nil(t, protojson.Unmarshal(b, &recorded))
+	if !assert.Equal(t, 2, len(recorded.GetHandlers())) {
+		return
+	}
+	matcher := pb.NewRequestMatcher(pb.NewValueInverter())
+	for i, x := range exchanges {
+		t.Run(x.target, func(t *testing.T) {
+			rh := recorded.GetHandlers()[i]
+			assert.Equal(t, "/users", rh.GetPath())
+			assert.Equal(t, pb.MethodType_GET, rh.GetMethodType())
+			// only the request of the exchange matches
+			for j, y := range exchanges {
+				ok, err := matcher.Match(rh.GetMatch(), newRequest(y), nil)
+				assert.Nil(t, err)
+				assert.Equal(t, i == j, ok, "request %d", j)
+			}
+			// replay
+			replay, err := handler.HandlerFromAction(rh.GetAction(), handler.NewEnv(http.DefaultClient))
+			assert.Nil(t, err)
+			w := httptest.NewRecorder()
+			replay.ServeHTTP(w, newRequest(x))
+			assert.Equal(t, x.status, w.Code)
+			if x.status == http.StatusOK {
+				assert.JSONEq(t, x.body, w.Body.String())
+				assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			} else {
+				assert.Equal(t, x.body, w.Body.String())
+				assert.Equal(t, "text/plain", w.Header().Get("Content-Type"))
+			}
+			assert.Equal(t, "", w.Header().Get("X-Not-Recorded"))
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
 
+	"github.com/berquerant/jsonhttp/internal/breaker"
 	"github.com/berquerant/jsonhttp/internal/errors"
 	"github.com/berquerant/jsonhttp/internal/util"
 	"github.com/berquerant/jsonhttp/pb"
@@ -22,6 +24,16 @@ func (Handler) getResponseStatus(givenStatus int, responseErr error) int {
 	if !ok {
 		return http.StatusInternalServerError
 	}
+	if x, ok := err.Find(errors.Code.IsUpstream); ok {
+		// the status chosen by the handler
+		if givenStatus >= http.StatusBadRequest {
+			return givenStatus
+		}
+		if x.Code() == errors.UpstreamTimeout {
+			return http.StatusGatewayTimeout
+		}
+		return http.StatusBadGateway
+	}
 	switch err.Code() {
 	case errors.UnknownError, errors.InvalidSettings:
 		return http.StatusInternalServerError
@@ -33,16 +45,29 @@ func (Handler) getResponseStatus(givenStatus int, responseErr error) int {
 }
 
 func (Handler) makeErrorResponseBody(err error) []byte {
-	b, _ := json.Marshal(map[string]string{
+	body := map[string]string{
 		"error":  err.Error(),
 		"result": "error",
-	})
+	}
+	if e, ok := errors.As(err); ok {
+		code := e.Code()
+		if x, ok := e.Find(errors.Code.IsUpstream); ok {
+			code = x.Code()
+		}
+		body["code"] = code.String()
+		if x := e.Cause(); x != nil {
+			body["cause"] = x.Error()
+		}
+	}
+	b, _ := json.Marshal(body)
 	return b
 }
 
 func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// read body
 	requestBody, err := io.ReadAll(r.Body)
+	// the original body is left to the handlers
+	r.Body = io.NopCloser(bytes.NewReader(requestBody))
 	if err != nil || len(requestBody) == 0 {
 		requestBody = []byte(`{}`) // use empty object
 	}
@@ -66,15 +91,27 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		c.Log().Error("responseErr trace: %s", err.Trace())
 	}
 	// set headers
-	for k, v := range nw.Headers().AsMap() {
-		w.Header().Add(k, v)
+	for k, vs := range nw.Headers().AsMap() {
+		for _, v := range vs {
+			w.Header().Add(k, v)
+		}
 	}
 	// prepare status code and body
 	status := h.getResponseStatus(nw.Status().Get(), responseErr)
+	stream, isStream := nw.Stream().Get()
+	if isStream {
+		defer stream.Close()
+	}
 	body := func() []byte {
+		if isStream && responseErr == nil {
+			return nil
+		}
 		if responseErr != nil {
 			return h.makeErrorResponseBody(responseErr)
 		}
+		if b, ok := nw.Raw().Get(); ok {
+			return b
+		}
 		b, err := json.Marshal(nw.Body().AsMap())
 		if err != nil {
 			status = http.StatusInternalServerError
@@ -83,12 +120,24 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return b
 	}()
-	w.Header().Set("Content-Length", fmt.Sprint(len(body)))
-	// set status code
-	w.WriteHeader(status)
-	// set body
-	if _, err := w.Write(body); err != nil {
-		c.Log().Error(`failed to write body %v %s`, err, body)
+	size := int64(len(body))
+	if isStream && responseErr == nil {
+		// set status code
+		w.WriteHeader(status)
+		// copy body
+		n, err := copyFlush(w, stream)
+		if err != nil {
+			c.Log().Error("failed to copy stream %v", err)
+		}
+		size = n
+	} else {
+		w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+		// set status code
+		w.WriteHeader(status)
+		// set body
+		if _, err := w.Write(body); err != nil {
+			c.Log().Error(`failed to write body %v %s`, err, body)
+		}
 	}
 	// stdout log
 	l := fmt.Sprintf(`%s %d %d %d %d "%s %s" "%s" %v`,
@@ -96,7 +145,7 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		elapsed.Milliseconds(),
 		status,
 		nr.ContentLength,
-		len(body),
+		size,
 		nr.Method,
 		nr.URL,
 		nr.UserAgent(),
@@ -109,12 +158,71 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func HandlerFromAction(h *pb.Action) (Handler, bool) {
+// copyFlush copies src to w, flushing each chunk to the client.
+func copyFlush(w http.ResponseWriter, src io.Reader) (int64, error) {
+	f, _ := w.(http.Flusher)
+	var (
+		buf     = make([]byte, 32*1024)
+		written int64
+	)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			m, werr := w.Write(buf[:n])
+			written += int64(m)
+			if werr != nil {
+				return written, werr
+			}
+			if f != nil {
+				f.Flush()
+			}
+		}
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+	}
+}
+
+func HandlerFromAction(h *pb.Action, env Env) (Handler, error) {
 	switch h.GetAction().(type) {
 	case *pb.Action_Return_:
-		return ReturnHandler(h.GetReturn()), true
+		return ReturnHandler(h.GetReturn()), nil
 	case *pb.Action_Gateway_:
-		return GatewayHandler(h.GetGateway()), true
+		return gatewayHandler(h.GetGateway(), env)
+	case *pb.Action_Aggregate_:
+		calls := h.GetAggregate().GetCalls()
+		gateways := make([]Handler, len(calls))
+		for i, x := range calls {
+			g, err := gatewayHandler(x.GetGateway(), env)
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidSettings, "aggregate call %s", x.GetName())
+			}
+			gateways[i] = g
+		}
+		return AggregateHandler(h.GetAggregate(), gateways), nil
+	}
+	return nil, errors.New(errors.InvalidSettings, "unknown action")
+}
+
+func gatewayHandler(gw *pb.Action_Gateway, env Env) (Handler, error) {
+	client, err := env.Client(gw.GetClient())
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InvalidSettings, "gateway client")
+	}
+	var cb breaker.Breaker
+	if x := gw.GetCircuitBreaker(); x != nil {
+		if cb, err = env.Breaker(x); err != nil {
+			return nil, errors.Wrap(err, errors.InvalidSettings, "gateway circuit breaker")
+		}
+	}
+	var rec Recorder
+	if x := gw.GetRecord(); x != nil {
+		if rec, err = env.Recorder(x.GetFile()); err != nil {
+			return nil, errors.Wrap(err, errors.InvalidSettings, "gateway recorder")
+		}
 	}
-	return nil, false
+	return GatewayHandler(gw, client, cb, rec), nil
 }
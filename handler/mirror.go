@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+)
+
+// sendMirrors sends copies of the request to the shadows asynchronously.
+// Call the returned function with the primary response, nil if failed, to diff the responses.
+func sendMirrors(c Context, m *pb.Mirror, client *http.Client, method, u string, headers map[string][]string, body []byte) func(res *http.Response, body []byte) {
+	const tag = "[mirror]"
+	var (
+		primaryC      = make(chan struct{})
+		primaryStatus int
+		primaryBody   []byte
+		primaryOK     bool
+	)
+	timeout := 30 * time.Second
+	if m.GetTimeout() > 0 {
+		timeout = time.Duration(m.GetTimeout()) * time.Millisecond
+	}
+	for _, base := range m.GetUrls() {
+		go func(base string) {
+			mu, err := mirrorURL(base, u)
+			if err != nil {
+				c.Log().Warn("%s %v", tag, err)
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			req, err := http.NewRequestWithContext(ctx, method, mu, bytes.NewReader(body))
+			if err != nil {
+				c.Log().Warn("%s build request to %s %v", tag, mu, err)
+				return
+			}
+			for k, vs := range headers {
+				for _, v := range vs {
+					req.Header.Add(k, v)
+				}
+			}
+			res, err := client.Do(req)
+			if err != nil {
+				c.Log().Warn("%s request to %s %v", tag, mu, err)
+				return
+			}
+			defer res.Body.Close()
+			b, err := io.ReadAll(res.Body)
+			if err != nil {
+				c.Log().Warn("%s read response from %s %v", tag, mu, err)
+				return
+			}
+			c.Log().Info("%s %s %s got status %d", tag, method, mu, res.StatusCode)
+			if !m.GetDiff() {
+				return
+			}
+			<-primaryC
+			if !primaryOK {
+				return
+			}
+			if d := cmp.Diff(diffTarget(primaryStatus, primaryBody), diffTarget(res.StatusCode, b)); d != "" {
+				c.Log().Warn("%s %s differs from primary (-primary +shadow)\n%s", tag, mu, d)
+				return
+			}
+			c.Log().Info("%s %s is the same as primary", tag, mu)
+		}(base)
+	}
+	return func(res *http.Response, body []byte) {
+		if res != nil {
+			primaryStatus = res.StatusCode
+			primaryBody = body
+			primaryOK = true
+		}
+		close(primaryC)
+	}
+}
+
+// diffTarget returns the parts of the response to diff.
+func diffTarget(status int, body []byte) map[string]interface{} {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		v = string(body)
+	}
+	return map[string]interface{}{
+		"status": status,
+		"body":   v,
+	}
+}
+
+// mirrorURL replaces the scheme and the host of u by base and prefixes the path of u by the path of base.
+func mirrorURL(base, u string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", errors.Wrapf(err, errors.InvalidValue, "parse mirror url %s", base)
+	}
+	x, err := url.Parse(u)
+	if err != nil {
+		return "", errors.Wrapf(err, errors.InvalidValue, "parse request url %s", u)
+	}
+	x.Scheme = b.Scheme
+	x.Host = b.Host
+	x.User = b.User
+	if p := strings.TrimSuffix(b.EscapedPath(), "/"); p != "" {
+		escaped := p + x.EscapedPath()
+		path, err := url.PathUnescape(escaped)
+		if err != nil {
+			return "", errors.Wrapf(err, errors.InvalidValue, "unescape path %s", escaped)
+		}
+		x.Path = path
+		x.RawPath = escaped
+	}
+	return x.String(), nil
+}
@@ -0,0 +1,201 @@
+package handler_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/stretchr/testify/assert"
+)
+
+// logBuffer captures the logs.
+type logBuffer struct {
+	sync.Mutex
+	b bytes.Buffer
+}
+
+func (s *logBuffer) Write(p []byte) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.b.Write(p)
+}
+
+func (s *logBuffer) String() string {
+	s.Lock()
+	defer s.Unlock()
+	return s.b.String()
+}
+
+func captureLog(t *testing.T) *logBuffer {
+	var b logBuffer
+	log.SetOutput(&b)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+	return &b
+}
+
+// shadowRequest is the request received by the mirror.
+type shadowRequest struct {
+	method string
+	uri    string
+	header http.Header
+	body   string
+}
+
+func newMirror(body string, release <-chan struct{}) (*httptest.Server, <-chan *shadowRequest) {
+	received := make(chan *shadowRequest, 1)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received <- &shadowRequest{
+			method: r.Method,
+			uri:    r.RequestURI,
+			header: r.Header,
+			body:   string(b),
+		}
+		if release != nil {
+			<-release
+		}
+		_, _ = io.WriteString(w, body)
+	})), received
+}
+
+func TestGatewayMirror(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"from":"primary"}`)
+	}))
+	defer upstream.Close()
+
+	t.Run("shadow request", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title  string
+			base   string
+			mirror string
+			target string
+			want   string
+		}{
+			{
+				title:  "path and query",
+				base:   "/v1?k=0",
+				target: "/a/b?x=1&x=2",
+				want:   "/v1/a/b?k=0&x=1&x=2",
+			},
+			{
+				title:  "mirror path prefix",
+				base:   "/v1",
+				mirror: "/shadow/",
+				target: "/a?x=1",
+				want:   "/shadow/v1/a?x=1",
+			},
+			{
+				title:  "escaped path",
+				base:   "/v1",
+				mirror: "/shadow",
+				target: "/a%2Fb",
+				want:   "/shadow/v1/a%2Fb",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				mirror, received := newMirror(`{}`, nil)
+				defer mirror.Close()
+				gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"passthrough":true,"mirror":{"urls":[%q]}}`,
+					upstream.URL+tc.base, mirror.URL+tc.mirror))
+				h := handler.GatewayHandler(gw, upstream.Client(), nil, nil)
+				r := httptest.NewRequest(http.MethodPut, tc.target, strings.NewReader(`{"a":1}`))
+				r.Header.Set("X-Test", "mirror")
+				r.Header.Add("X-Multi", "1")
+				r.Header.Add("X-Multi", "2")
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, r)
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, `{"from":"primary"}`, w.Body.String())
+
+				select {
+				case got := <-received:
+					assert.Equal(t, http.MethodPut, got.method)
+					assert.Equal(t, tc.want, got.uri)
+					assert.Equal(t, "mirror", got.header.Get("X-Test"))
+					assert.Equal(t, []string{"1", "2"}, got.header.Values("X-Multi"))
+					assert.Equal(t, `{"a":1}`, got.body)
+				case <-time.After(3 * time.Second):
+					t.Fatal("no shadow request")
+				}
+			})
+		}
+	})
+
+	t.Run("slow mirror", func(t *testing.T) {
+		for _, diff := range []bool{false, true} {
+			t.Run(fmt.Sprintf("diff %v", diff), func(t *testing.T) {
+				release := make(chan struct{})
+				mirror, received := newMirror(`{}`, release)
+				defer mirror.Close()
+				defer close(release)
+				gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"mirror":{"urls":[%q],"diff":%v}}`,
+					upstream.URL, mirror.URL, diff))
+				h := handler.GatewayHandler(gw, upstream.Client(), nil, nil)
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+				assert.Equal(t, `{"from":"primary"}`, w.Body.String())
+				// the mirror is still responding
+				<-received
+			})
+		}
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title   string
+			diff    bool
+			body    string
+			want    string
+			notWant string
+		}{
+			{
+				title:   "differs",
+				diff:    true,
+				body:    `{"from":"shadow"}`,
+				want:    "differs from primary",
+				notWant: "is the same as primary",
+			},
+			{
+				title:   "same",
+				diff:    true,
+				body:    `{"from":"primary"}`,
+				want:    "is the same as primary",
+				notWant: "differs from primary",
+			},
+			{
+				title:   "no diff",
+				body:    `{"from":"shadow"}`,
+				want:    "got status 200",
+				notWant: "primary",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				logs := captureLog(t)
+				mirror, _ := newMirror(tc.body, nil)
+				defer mirror.Close()
+				gw := newGateway(t, fmt.Sprintf(`{"path":{"s":%q},"mirror":{"urls":[%q],"diff":%v}}`,
+					upstream.URL, mirror.URL, tc.diff))
+				h := handler.GatewayHandler(gw, upstream.Client(), nil, nil)
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+				assert.Equal(t, `{"from":"primary"}`, w.Body.String())
+				assert.Eventually(t, func() bool {
+					return strings.Contains(logs.String(), tc.want)
+				}, 3*time.Second, 10*time.Millisecond)
+				// the diff is logged after the status
+				time.Sleep(50 * time.Millisecond)
+				assert.NotContains(t, logs.String(), tc.notWant)
+			})
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Recorder saves handlers into a file as Server.
+type Recorder interface {
+	// Record adds the handler, replaces the handler of the same path, method and match.
+	Record(h *pb.Handler) error
+}
+
+// NewRecorder returns a new recorder of the file, keeps the handlers in the file if exists.
+func NewRecorder(file string) (Recorder, error) {
+	r := &recorder{
+		file: file,
+	}
+	b, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidSettings, "read record file %s", file)
+	}
+	var v pb.Server
+	if err := protojson.Unmarshal(b, &v); err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidSettings, "parse record file %s", file)
+	}
+	r.handlers = v.GetHandlers()
+	return r, nil
+}
+
+type recorder struct {
+	sync.Mutex
+	file     string
+	handlers []*pb.Handler
+}
+
+func (s *recorder) Record(h *pb.Handler) error {
+	s.Lock()
+	defer s.Unlock()
+	s.put(h)
+	b, err := protojson.MarshalOptions{
+		Multiline: true,
+		Indent:    "  ",
+	}.Marshal(&pb.Server{
+		Handlers: s.handlers,
+	})
+	if err != nil {
+		return errors.Wrapf(err, errors.Jsonify, "marshal records")
+	}
+	// write the whole file at once not to leave a broken file
+	tmp, err := os.CreateTemp(filepath.Dir(s.file), filepath.Base(s.file)+".*")
+	if err != nil {
+		return errors.Wrapf(err, errors.Handler, "create record file %s", s.file)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, errors.Handler, "write record file %s", s.file)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, errors.Handler, "close record file %s", s.file)
+	}
+	if err := os.Rename(tmp.Name(), s.file); err != nil {
+		return errors.Wrapf(err, errors.Handler, "rename record file %s", s.file)
+	}
+	return nil
+}
+
+func (s *recorder) put(h *pb.Handler) {
+	for i, x := range s.handlers {
+		if x.GetPath() == h.GetPath() && x.GetMethodType() == h.GetMethodType() && proto.Equal(x.GetMatch(), h.GetMatch()) {
+			s.handlers[i] = h
+			return
+		}
+	}
+	s.handlers = append(s.handlers, h)
+}
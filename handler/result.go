@@ -1,18 +1,30 @@
 package handler
 
-import "net/http"
+import (
+	"io"
+	"net/http"
+)
 
 type (
 	ResultWriter interface {
 		Headers() Headers
 		Body() Body
 		Status() Status
+		// Raw is written as the body instead of Body if set.
+		Raw() RawBody
+		// Stream is copied to the client as it arrives instead of Body and Raw if set.
+		Stream() StreamBody
 	}
 	Headers interface {
+		// Get returns the first value of the key.
 		Get(key string) (string, bool)
+		Values(key string) []string
+		// Set replaces the values of the key.
 		Set(key, value string)
+		// Add appends the value to the key.
+		Add(key, value string)
 		Del(key string)
-		AsMap() map[string]string
+		AsMap() map[string][]string
 	}
 	Body interface {
 		Get(key string) (interface{}, bool)
@@ -24,6 +36,15 @@ type (
 		Get() int
 		Set(statusCode int)
 	}
+	RawBody interface {
+		Get() ([]byte, bool)
+		Set(body []byte)
+	}
+	StreamBody interface {
+		Get() (io.ReadCloser, bool)
+		// Set replaces the body, the writer closes it.
+		Set(body io.ReadCloser)
+	}
 )
 
 func NewResultWriter() ResultWriter {
@@ -31,6 +52,8 @@ func NewResultWriter() ResultWriter {
 		headers: NewHeaders(),
 		body:    NewBody(),
 		status:  NewStatus(),
+		raw:     NewRawBody(),
+		stream:  NewStreamBody(),
 	}
 }
 
@@ -38,29 +61,38 @@ type resultWriter struct {
 	headers Headers
 	body    Body
 	status  Status
+	raw     RawBody
+	stream  StreamBody
 }
 
-func (s *resultWriter) Headers() Headers { return s.headers }
-func (s *resultWriter) Body() Body       { return s.body }
-func (s *resultWriter) Status() Status   { return s.status }
+func (s *resultWriter) Headers() Headers   { return s.headers }
+func (s *resultWriter) Body() Body         { return s.body }
+func (s *resultWriter) Status() Status     { return s.status }
+func (s *resultWriter) Raw() RawBody       { return s.raw }
+func (s *resultWriter) Stream() StreamBody { return s.stream }
 
 func NewHeaders() Headers {
 	return &headers{
-		v: map[string]string{},
+		v: http.Header{},
 	}
 }
 
 type headers struct {
-	v map[string]string
+	v http.Header
 }
 
 func (s *headers) Get(key string) (string, bool) {
-	x, ok := s.v[key]
-	return x, ok
+	x := s.v.Values(key)
+	if len(x) == 0 {
+		return "", false
+	}
+	return x[0], true
 }
-func (s *headers) Set(key, value string)    { s.v[key] = value }
-func (s *headers) Del(key string)           { delete(s.v, key) }
-func (s *headers) AsMap() map[string]string { return s.v }
+func (s *headers) Values(key string) []string { return s.v.Values(key) }
+func (s *headers) Set(key, value string)      { s.v.Set(key, value) }
+func (s *headers) Add(key, value string)      { s.v.Add(key, value) }
+func (s *headers) Del(key string)             { s.v.Del(key) }
+func (s *headers) AsMap() map[string][]string { return s.v }
 
 func NewBody() Body {
 	return &body{
@@ -92,3 +124,30 @@ type status struct {
 
 func (s *status) Get() int           { return s.v }
 func (s *status) Set(statusCode int) { s.v = statusCode }
+
+func NewRawBody() RawBody {
+	return &rawBody{}
+}
+
+type rawBody struct {
+	v []byte
+}
+
+func (s *rawBody) Get() ([]byte, bool) { return s.v, s.v != nil }
+func (s *rawBody) Set(body []byte) {
+	if body == nil {
+		body = []byte{}
+	}
+	s.v = body
+}
+
+func NewStreamBody() StreamBody {
+	return &streamBody{}
+}
+
+type streamBody struct {
+	v io.ReadCloser
+}
+
+func (s *streamBody) Get() (io.ReadCloser, bool) { return s.v, s.v != nil }
+func (s *streamBody) Set(body io.ReadCloser)     { s.v = body }
@@ -15,7 +15,7 @@ func ReturnHandler(ret *pb.Action_Return) Handler {
 	return func(w ResultWriter, r *http.Request) error {
 		var (
 			c       = FromContext(r.Context())
-			src     = pb.NewTemplateSource(r.URL, &r.Header, c.Body())
+			src     = NewRequestTemplateSource(r)
 			doDelay = func(src pb.TemplateSource) error {
 				if ret.GetDelay() == nil {
 					return nil
@@ -33,9 +33,33 @@ func ReturnHandler(ret *pb.Action_Return) Handler {
 				time.Sleep(time.Duration(d) * time.Millisecond)
 				return nil
 			}
+			buildStatus = func(src pb.TemplateSource) (int, error) {
+				if ret.GetStatusValue() == nil {
+					return int(ret.GetStatus()), nil
+				}
+				v, err := NewTemplateValueBuilder().Build(ret.GetStatusValue(), src)
+				if err != nil {
+					c.Log().Error("%s at calculate status %v", tag, err)
+					return 0, err
+				}
+				d, err := pb.NewValueCaster().Int(v)
+				if err != nil {
+					return 0, errors.Wrapf(err, errors.InvalidValue, "%s status is not int %v", tag, util.JSON(v))
+				}
+				if !util.IsHTTPStatus(d) {
+					return 0, errors.Newf(errors.InvalidValue, "%s invalid status %d", tag, d)
+				}
+				return d, nil
+			}
 		)
 
-		w.Status().Set(int(ret.GetStatus()))
+		status, err := buildStatus(src)
+		if err != nil {
+			return err
+		}
+		if status != 0 {
+			w.Status().Set(status)
+		}
 		writeTemplate := func() error {
 			b := NewTemplatesBuilder()
 			for i, t := range ret.GetTemplates() {
@@ -44,13 +68,20 @@ func ReturnHandler(ret *pb.Action_Return) Handler {
 					return err
 				}
 			}
-			w.Status().Set(int(ret.GetStatus()))
-			for k, v := range b.Headers() {
-				w.Headers().Set(k, v)
-			}
+			WriteHeaders(w.Headers(), b.Headers())
 			for k, v := range b.Body() {
 				w.Body().Set(k, v)
 			}
+			if err := b.Edit(w.Body().AsMap(), w.Headers().AsMap()); err != nil {
+				c.Log().Error("%s edit templates %v", tag, err)
+				return err
+			}
+			if ret.GetRaw() != "" {
+				w.Raw().Set([]byte(ret.GetRaw()))
+			}
+			if len(ret.GetRawBytes()) > 0 {
+				w.Raw().Set(ret.GetRawBytes())
+			}
 			return doDelay(src)
 		}
 		switch ret.GetTemplateType() {
@@ -60,7 +91,7 @@ func ReturnHandler(ret *pb.Action_Return) Handler {
 			}
 			return writeTemplate()
 		case pb.Action_SELECT:
-			if len(ret.GetTemplates()) == 0 {
+			if len(ret.GetTemplates()) == 0 && ret.GetRaw() == "" && len(ret.GetRawBytes()) == 0 {
 				if err := WriteResultFromSource(w, pb.NewTemplateSource(nil, &r.Header, c.Body())); err != nil {
 					return errors.Wrapf(err, errors.Handler, "%s write result %s", tag, c.Body())
 				}
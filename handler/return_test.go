@@ -0,0 +1,124 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newReturn(t *testing.T, config string) *pb.Action_Return {
+	var ret pb.Action_Return
+	assert.Nil(t, protojson.Unmarshal([]byte(config), &ret))
+	return &ret
+}
+
+func TestReturnHandlerStatus(t *testing.T) {
+	for _, tc := range []*struct {
+		title    string
+		config   string
+		target   string
+		body     string
+		want     int
+		wantCode string
+	}{
+		{
+			title:  "no status",
+			config: `{}`,
+			target: "/",
+			want:   http.StatusOK,
+		},
+		{
+			title:  "status 0",
+			config: `{"status":0,"raw":"ok"}`,
+			target: "/",
+			want:   http.StatusOK,
+		},
+		{
+			title:  "status",
+			config: `{"status":201}`,
+			target: "/",
+			want:   http.StatusCreated,
+		},
+		{
+			title:  "status value from query",
+			config: `{"statusValue":{"expr":"default(query.status, 200)"}}`,
+			target: "/?status=404",
+			want:   http.StatusNotFound,
+		},
+		{
+			title:  "status value default",
+			config: `{"statusValue":{"expr":"default(query.status, 200)"}}`,
+			target: "/",
+			want:   http.StatusOK,
+		},
+		{
+			title:  "status value from body",
+			config: `{"statusValue":{"body":{"keys":["status"]}}}`,
+			target: "/",
+			body:   `{"status":503}`,
+			want:   http.StatusServiceUnavailable,
+		},
+		{
+			title:  "status value overrides status",
+			config: `{"status":201,"statusValue":{"n":202}}`,
+			target: "/",
+			want:   http.StatusAccepted,
+		},
+		{
+			title:    "status value out of range",
+			config:   `{"statusValue":{"expr":"query.status"}}`,
+			target:   "/?status=999",
+			want:     http.StatusBadRequest,
+			wantCode: "InvalidValue",
+		},
+		{
+			title:    "status value 0",
+			config:   `{"statusValue":{"n":0}}`,
+			target:   "/",
+			want:     http.StatusBadRequest,
+			wantCode: "InvalidValue",
+		},
+		{
+			title:    "status value not int",
+			config:   `{"statusValue":{"expr":"query.status"}}`,
+			target:   "/?status=ok",
+			want:     http.StatusBadRequest,
+			wantCode: "InvalidValue",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			h := handler.ReturnHandler(newReturn(t, tc.config))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tc.target, strings.NewReader(tc.body)))
+			assert.Equal(t, tc.want, w.Code)
+			if tc.wantCode == "" {
+				return
+			}
+			var got map[string]string
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, tc.wantCode, got["code"])
+		})
+	}
+}
+
+func TestReturnHandlerMultiValuedHeaders(t *testing.T) {
+	h := handler.ReturnHandler(newReturn(t, `{"templates":[
+{"type":"HEADER","value":{"m":{"values":{"X-Multi":{"l":{"values":[{"s":"1"},{"s":"2"}]}}}}}},
+{"type":"COOKIE","value":{"l":{"values":[
+  {"m":{"values":{"name":{"s":"a"},"value":{"s":"x"}}}},
+  {"m":{"values":{"name":{"s":"b"},"value":{"s":"y"},"httpOnly":{"b":true}}}}
+]}}}
+]}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"1", "2"}, w.Header().Values("X-Multi"))
+	assert.Equal(t, []string{"a=x", "b=y; HttpOnly"}, w.Header().Values("Set-Cookie"))
+}
@@ -2,14 +2,23 @@ package handler
 
 import (
 	"encoding/json"
+	"net/http"
 
 	"github.com/berquerant/jsonhttp/pb"
 )
 
+var (
+	// exprParser is shared by the template value builders to parse each expression once.
+	exprParser = pb.NewExprParser()
+	// formatParser is shared by the template value builders to parse each format once.
+	formatParser = pb.NewFormatParser()
+)
+
 func NewTemplateValueBuilder() pb.TemplateValueBuilder {
 	valueConverter := pb.NewValueConverter()
 	valueCaster := pb.NewValueCaster()
 	valueCoercer := pb.NewValueCoercer(valueCaster)
+	valueInverter := pb.NewValueInverter()
 	bodyBF := func(b *pb.Value_Body) pb.BodyBuilder {
 		return pb.NewBodyBuilder(b, valueConverter)
 	}
@@ -19,6 +28,36 @@ func NewTemplateValueBuilder() pb.TemplateValueBuilder {
 	castBF := func(c *pb.Value_Cast, s pb.TemplateValueBuilder) pb.CastBuilder {
 		return pb.NewCastBuilder(c, valueCoercer, s)
 	}
+	formatBF := func(f string, s pb.TemplateValueBuilder) pb.FormatBuilder {
+		return pb.NewFormatBuilder(f, formatParser, s)
+	}
+	exprBF := func(e string) pb.ExprBuilder {
+		return pb.NewExprBuilder(e, exprParser, valueConverter)
+	}
+	dumpBF := func(d *pb.Value_Dump) pb.DumpBuilder {
+		return pb.NewDumpBuilder(d, valueConverter)
+	}
+	jsonParseBF := func(j *pb.Value_JsonParse, s pb.TemplateValueBuilder) pb.JSONParseBuilder {
+		return pb.NewJSONParseBuilder(j, valueCaster, valueConverter, s)
+	}
+	jsonStringifyBF := func(j *pb.Value_JsonStringify, s pb.TemplateValueBuilder) pb.JSONStringifyBuilder {
+		return pb.NewJSONStringifyBuilder(j, valueInverter, s)
+	}
+	encodeBF := func(e *pb.Value_Encode, s pb.TemplateValueBuilder) pb.EncodeBuilder {
+		return pb.NewEncodeBuilder(e, valueCaster, s)
+	}
+	decodeBF := func(d *pb.Value_Decode, s pb.TemplateValueBuilder) pb.DecodeBuilder {
+		return pb.NewDecodeBuilder(d, valueCaster, s)
+	}
+	hashBF := func(h *pb.Value_Hash, s pb.TemplateValueBuilder) pb.HashBuilder {
+		return pb.NewHashBuilder(h, valueCaster, s)
+	}
+	collectionBF := func(c *pb.Value_Collection, s pb.TemplateValueBuilder) pb.CollectionBuilder {
+		return pb.NewCollectionBuilder(c, valueCaster, s)
+	}
+	upstreamBF := func(u *pb.Value_Upstream) pb.UpstreamBuilder {
+		return pb.NewUpstreamBuilder(u, valueConverter)
+	}
 	return pb.NewTemplateValueBuilder(
 		bodyBF,
 		pb.NewUtilBuilder,
@@ -26,11 +65,38 @@ func NewTemplateValueBuilder() pb.TemplateValueBuilder {
 		pb.NewURLBuilder,
 		addBF,
 		castBF,
+		formatBF,
+		exprBF,
+		pb.NewRequestBuilder,
+		pb.NewCookieBuilder,
+		dumpBF,
+		jsonParseBF,
+		jsonStringifyBF,
+		encodeBF,
+		decodeBF,
+		hashBF,
+		collectionBF,
+		pb.NewItemBuilder,
+		upstreamBF,
+		pb.NewParamBuilder,
 	)
 }
 
+// NewRequestTemplateSource returns the template source of the request in the handler.
+func NewRequestTemplateSource(r *http.Request) pb.TemplateSource {
+	c := FromContext(r.Context())
+	src := pb.NewRequestTemplateSource(r, c.Body(), c.ID())
+	if xs := c.Upstreams(); len(xs) > 0 {
+		src = pb.NewUpstreamTemplateSource(src, xs)
+	}
+	if xs := PathParams(r.Context()); len(xs) > 0 {
+		src = pb.NewPathParamsTemplateSource(src, xs)
+	}
+	return src
+}
+
 func NewTemplatesBuilder() pb.TemplatesBuilder {
-	return pb.NewTemplatesBuilder(NewTemplateValueBuilder(), pb.NewValueInverter())
+	return pb.NewTemplatesBuilder(NewTemplateValueBuilder(), pb.NewValueInverter(), pb.NewSetCookieBuilder(pb.NewValueCaster()))
 }
 
 func WriteResultFromSource(w ResultWriter, src pb.TemplateSource) error {
@@ -41,10 +107,16 @@ func WriteResultFromSource(w ResultWriter, src pb.TemplateSource) error {
 	for k, v := range m {
 		w.Body().Set(k, v)
 	}
-	for k, v := range *src.Header() {
-		if len(v) > 0 {
-			w.Headers().Set(k, v[0])
+	WriteHeaders(w.Headers(), *src.Header())
+	return nil
+}
+
+// WriteHeaders replaces the values of the headers by the values from src.
+func WriteHeaders(w Headers, src map[string][]string) {
+	for k, vs := range src {
+		w.Del(k)
+		for _, v := range vs {
+			w.Add(k, v)
 		}
 	}
-	return nil
 }
@@ -0,0 +1,295 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/jsonschema"
+	"github.com/berquerant/jsonhttp/internal/openapi"
+	"github.com/berquerant/jsonhttp/pb"
+)
+
+// Violation is a failure of the request validation.
+type Violation struct {
+	// In is body, query, header or path.
+	In      string `json:"in"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// RequestValidator checks the request against the schemas.
+type RequestValidator interface {
+	// Validate returns the violations of the request, body is the original request body,
+	// returns an error if the body is not json.
+	Validate(r *http.Request, body []byte) ([]*Violation, error)
+}
+
+// NewRequestValidator returns a new validator of the validate of the handler,
+// loads the schema files.
+func NewRequestValidator(h *pb.Handler) (RequestValidator, error) {
+	v := h.GetValidate()
+	s := &requestValidator{}
+	var err error
+	if s.schemas.body, err = newSchema(v.GetBody()); err != nil {
+		return nil, errors.Wrap(err, errors.InvalidSettings, "body schema")
+	}
+	s.schemas.bodyRequired = s.schemas.body != nil
+	if s.schemas.query, err = newSchema(v.GetQuery()); err != nil {
+		return nil, errors.Wrap(err, errors.InvalidSettings, "query schema")
+	}
+	if s.schemas.header, err = newSchema(v.GetHeader()); err != nil {
+		return nil, errors.Wrap(err, errors.InvalidSettings, "header schema")
+	}
+	if x := v.GetOpenapi(); x != nil {
+		path := x.GetPath()
+		if path == "" {
+			path = h.GetPath()
+		}
+		if s.operations, err = newOperationSchemas(x.GetFile(), path); err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidSettings, "openapi %s %s", x.GetFile(), path)
+		}
+	}
+	return s, nil
+}
+
+func newSchema(s *pb.Schema) (jsonschema.Schema, error) {
+	switch s.GetSchema().(type) {
+	case *pb.Schema_Inline:
+		return jsonschema.New(s.GetInline().AsMap(), nil)
+	case *pb.Schema_File:
+		b, err := os.ReadFile(s.GetFile())
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidSettings, "read schema %s", s.GetFile())
+		}
+		return jsonschema.Parse(b)
+	}
+	return nil, nil
+}
+
+// newOperationSchemas returns the schemas of the operations of the path by method.
+func newOperationSchemas(file, path string) (map[string]*requestSchemas, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := openapi.Parse(b)
+	if err != nil {
+		return nil, err
+	}
+	item, ok := doc.Paths[path]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "path %s", path)
+	}
+	ops := map[string]*requestSchemas{}
+	for method, op := range item.Operations() {
+		s, err := newOperationSchema(doc, item, op)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InvalidSettings, method)
+		}
+		ops[method] = s
+	}
+	return ops, nil
+}
+
+func newOperationSchema(doc *openapi.Document, item *openapi.PathItem, op *openapi.Operation) (*requestSchemas, error) {
+	type object struct {
+		Type       string                     `json:"type"`
+		Properties map[string]*openapi.Schema `json:"properties"`
+		Required   []string                   `json:"required,omitempty"`
+	}
+	objects := map[string]*object{}
+	// the parameters of the operation override the parameters of the path
+	seen := map[string]bool{}
+	for _, params := range [][]*openapi.Parameter{op.Parameters, item.Parameters} {
+		for _, x := range params {
+			p, err := doc.Parameter(x)
+			if err != nil {
+				return nil, err
+			}
+			name := p.Name
+			if p.In == "header" {
+				name = http.CanonicalHeaderKey(name)
+			}
+			if seen[p.In+":"+name] {
+				continue
+			}
+			seen[p.In+":"+name] = true
+			o, ok := objects[p.In]
+			if !ok {
+				o = &object{
+					Type:       "object",
+					Properties: map[string]*openapi.Schema{},
+				}
+				objects[p.In] = o
+			}
+			schema := p.Schema
+			if schema == nil {
+				schema = &openapi.Schema{}
+			}
+			o.Properties[name] = schema
+			if p.Required || p.In == "path" {
+				o.Required = append(o.Required, name)
+			}
+		}
+	}
+	var (
+		s   requestSchemas
+		err error
+	)
+	for in, dst := range map[string]*jsonschema.Schema{
+		"query":  &s.query,
+		"header": &s.header,
+		"path":   &s.path,
+	} {
+		if o, ok := objects[in]; ok {
+			if *dst, err = jsonschema.New(o, doc); err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidSettings, "%s parameters", in)
+			}
+		}
+	}
+	body, err := doc.RequestBody(op.RequestBody)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return &s, nil
+	}
+	s.bodyRequired = body.Required
+	m, ok := body.Content["application/json"]
+	if !ok {
+		for typ, x := range body.Content {
+			if strings.Contains(typ, "json") {
+				m = x
+				break
+			}
+		}
+	}
+	if m != nil && m.Schema != nil {
+		if s.body, err = jsonschema.New(m.Schema, doc); err != nil {
+			return nil, errors.Wrap(err, errors.InvalidSettings, "request body")
+		}
+	}
+	return &s, nil
+}
+
+// requestSchemas are the schemas of the parts of the request, nil means no validation.
+type requestSchemas struct {
+	// the empty body is a violation if true, otherwise not validated
+	bodyRequired bool
+	body         jsonschema.Schema
+	query        jsonschema.Schema
+	header       jsonschema.Schema
+	path         jsonschema.Schema
+}
+
+type requestValidator struct {
+	schemas requestSchemas
+	// schemas of the openapi operations by method
+	operations map[string]*requestSchemas
+}
+
+func (s *requestValidator) Validate(r *http.Request, body []byte) ([]*Violation, error) {
+	vs, err := s.schemas.validate(r, body)
+	if err != nil {
+		return nil, err
+	}
+	if x, ok := s.operations[r.Method]; ok {
+		xs, err := x.validate(r, body)
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, xs...)
+	}
+	return vs, nil
+}
+
+func (s *requestSchemas) validate(r *http.Request, body []byte) ([]*Violation, error) {
+	var vs []*Violation
+	add := func(in string, schema jsonschema.Schema, v interface{}) {
+		for _, x := range schema.Validate(v) {
+			vs = append(vs, &Violation{
+				In:      in,
+				Path:    x.Path,
+				Message: x.Message,
+			})
+		}
+	}
+	switch {
+	case len(bytes.TrimSpace(body)) == 0:
+		if s.bodyRequired {
+			vs = append(vs, &Violation{
+				In:      "body",
+				Path:    "/",
+				Message: "body is required",
+			})
+		}
+	case s.body != nil:
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, errors.Wrap(err, errors.InvalidArgument, "request body is not json")
+		}
+		add("body", s.body, v)
+	}
+	if s.query != nil {
+		add("query", s.query, s.query.Object(r.URL.Query()))
+	}
+	if s.header != nil {
+		m := map[string][]string{}
+		for k, v := range r.Header {
+			m[http.CanonicalHeaderKey(k)] = v
+		}
+		add("header", s.header, s.header.Object(m))
+	}
+	if s.path != nil {
+		m := map[string][]string{}
+		for k, v := range PathParams(r.Context()) {
+			m[k] = []string{v}
+		}
+		add("path", s.path, s.path.Object(m))
+	}
+	return vs, nil
+}
+
+// ValidateHandler checks the request before next,
+// responds the violations with the status of the validate instead of next if the request is invalid.
+func ValidateHandler(v *pb.Validation, validator RequestValidator, next Handler) Handler {
+	status := int(v.GetStatus())
+	if status == 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	return func(w ResultWriter, r *http.Request) error {
+		c := FromContext(r.Context())
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return errors.Wrap(err, errors.InvalidArgument, "read request body")
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		vs, err := validator.Validate(r, body)
+		if err != nil {
+			c.Log().Warn("invalid request %v", err)
+			w.Status().Set(http.StatusBadRequest)
+			w.Body().Set("result", "error")
+			w.Body().Set("error", "invalid request")
+			w.Body().Set("violations", []*Violation{{
+				In:      "body",
+				Path:    "/",
+				Message: "should be json",
+			}})
+			return nil
+		}
+		if len(vs) == 0 {
+			return next(w, r)
+		}
+		c.Log().Warn("invalid request %d violations", len(vs))
+		w.Status().Set(status)
+		w.Body().Set("result", "error")
+		w.Body().Set("error", "invalid request")
+		w.Body().Set("violations", vs)
+		return nil
+	}
+}
@@ -0,0 +1,152 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const validateDocument = `
+openapi: 3.1.0
+info: {title: items, version: 1.0.0}
+paths:
+  /items:
+    post:
+      parameters:
+        - {name: id, in: query, schema: {type: array, items: {type: integer, maximum: 3}}}
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema: {type: object, required: [name]}
+      responses:
+        '200': {description: ok}
+    put:
+      requestBody:
+        content:
+          application/json:
+            schema: {type: object, required: [name]}
+      responses:
+        '200': {description: ok}
+`
+
+func TestValidateHandler(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "openapi.yaml")
+	assert.Nil(t, os.WriteFile(file, []byte(validateDocument), 0600))
+
+	type violation struct {
+		In      string `json:"in"`
+		Path    string `json:"path"`
+		Message string `json:"message"`
+	}
+	for _, tc := range []*struct {
+		title  string
+		config string
+		method string
+		target string
+		body   string
+		status int
+		want   []violation
+	}{
+		{
+			title:  "valid",
+			config: `{"path":"/items","validate":{"body":{"inline":{"type":"object"}}}}`,
+			method: http.MethodPost,
+			target: "/items",
+			body:   `{}`,
+			status: http.StatusOK,
+		},
+		{
+			title:  "empty body with schema",
+			config: `{"path":"/items","validate":{"body":{"inline":{"type":"object"}}}}`,
+			method: http.MethodPost,
+			target: "/items",
+			status: http.StatusUnprocessableEntity,
+			want: []violation{
+				{In: "body", Path: "/", Message: "body is required"},
+			},
+		},
+		{
+			title:  "malformed body",
+			config: `{"path":"/items","validate":{"body":{"inline":{"type":"object"}},"status":400}}`,
+			method: http.MethodPost,
+			target: "/items",
+			body:   `{`,
+			status: http.StatusBadRequest,
+			want: []violation{
+				{In: "body", Path: "/", Message: "should be json"},
+			},
+		},
+		{
+			title:  "array query",
+			config: `{"path":"/items","validate":{"query":{"inline":{"properties":{"id":{"type":"array","items":{"type":"integer","maximum":3}}}}}}}`,
+			method: http.MethodGet,
+			target: "/items?id=1&id=5",
+			status: http.StatusUnprocessableEntity,
+			want: []violation{
+				{In: "query", Path: "/id/1", Message: "should be <= 3"},
+			},
+		},
+		{
+			title:  "openapi required body",
+			config: `{"path":"/items","validate":{"openapi":{"file":"` + file + `"}}}`,
+			method: http.MethodPost,
+			target: "/items?id=1&id=2&id=4",
+			status: http.StatusUnprocessableEntity,
+			want: []violation{
+				{In: "body", Path: "/", Message: "body is required"},
+				{In: "query", Path: "/id/2", Message: "should be <= 3"},
+			},
+		},
+		{
+			title:  "openapi optional body",
+			config: `{"path":"/items","validate":{"openapi":{"file":"` + file + `"}}}`,
+			method: http.MethodPut,
+			target: "/items",
+			status: http.StatusOK,
+		},
+		{
+			title:  "openapi invalid optional body",
+			config: `{"path":"/items","validate":{"openapi":{"file":"` + file + `"}}}`,
+			method: http.MethodPut,
+			target: "/items",
+			body:   `{"id":1}`,
+			status: http.StatusUnprocessableEntity,
+			want: []violation{
+				{In: "body", Path: "/", Message: "name is required"},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var x pb.Handler
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.config), &x))
+			validator, err := handler.NewRequestValidator(&x)
+			assert.Nil(t, err)
+			next := handler.Handler(func(w handler.ResultWriter, _ *http.Request) error {
+				w.Status().Set(http.StatusOK)
+				return nil
+			})
+			w := httptest.NewRecorder()
+			handler.ValidateHandler(x.GetValidate(), validator, next).
+				ServeHTTP(w, httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)))
+			assert.Equal(t, tc.status, w.Code)
+			if tc.want == nil {
+				return
+			}
+			var got struct {
+				Violations []violation `json:"violations"`
+			}
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, tc.want, got.Violations)
+		})
+	}
+}
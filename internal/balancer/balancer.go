@@ -0,0 +1,169 @@
+// Package balancer selects upstream targets with passive health tracking.
+package balancer
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+type Strategy int
+
+const (
+	RoundRobin Strategy = iota
+	Random
+	Weighted
+	LeastOutstanding
+)
+
+type Config struct {
+	Strategy Strategy
+	// Weights of targets for Weighted, non-positive means 1.
+	// The length is the number of targets.
+	Weights []int
+	// MaxFails is the number of consecutive failures to eject a target, 0 means never.
+	MaxFails int
+	// EjectDuration is the duration to exclude an ejected target from selection.
+	EjectDuration time.Duration
+	// Now returns the current time, time.Now if nil.
+	Now func() time.Time
+	// Intn returns a random number in [0, n), math/rand.Intn if nil.
+	Intn func(n int) int
+}
+
+// Balancer selects targets.
+type Balancer interface {
+	// Next returns the index of the selected target
+	// and the function to report the result of the request to the target.
+	// Selects from all targets if all of them are ejected.
+	Next() (int, func(ok bool))
+}
+
+func New(c *Config) Balancer {
+	now := c.Now
+	if now == nil {
+		now = time.Now
+	}
+	intn := c.Intn
+	if intn == nil {
+		intn = rand.Intn
+	}
+	targets := make([]*target, len(c.Weights))
+	for i, w := range c.Weights {
+		if w <= 0 {
+			w = 1
+		}
+		targets[i] = &target{
+			weight: w,
+		}
+	}
+	return &balancer{
+		strategy:      c.Strategy,
+		targets:       targets,
+		maxFails:      c.MaxFails,
+		ejectDuration: c.EjectDuration,
+		now:           now,
+		intn:          intn,
+	}
+}
+
+type target struct {
+	weight       int
+	outstanding  int
+	fails        int
+	ejectedUntil time.Time
+}
+
+type balancer struct {
+	sync.Mutex
+	strategy      Strategy
+	targets       []*target
+	maxFails      int
+	ejectDuration time.Duration
+	now           func() time.Time
+	intn          func(n int) int
+	cursor        int
+}
+
+func (s *balancer) Next() (int, func(ok bool)) {
+	s.Lock()
+	defer s.Unlock()
+	i := s.selectTarget(s.available())
+	t := s.targets[i]
+	t.outstanding++
+	var once sync.Once
+	return i, func(ok bool) {
+		once.Do(func() { s.done(t, ok) })
+	}
+}
+
+func (s *balancer) done(t *target, ok bool) {
+	s.Lock()
+	defer s.Unlock()
+	t.outstanding--
+	if ok {
+		t.fails = 0
+		return
+	}
+	t.fails++
+	if s.maxFails > 0 && t.fails >= s.maxFails {
+		t.fails = 0
+		t.ejectedUntil = s.now().Add(s.ejectDuration)
+	}
+}
+
+// available returns the indexes of the targets not ejected, all of them if none.
+func (s *balancer) available() []int {
+	var (
+		now = s.now()
+		xs  []int
+	)
+	for i, t := range s.targets {
+		if !now.Before(t.ejectedUntil) {
+			xs = append(xs, i)
+		}
+	}
+	if len(xs) > 0 {
+		return xs
+	}
+	xs = make([]int, len(s.targets))
+	for i := range s.targets {
+		xs[i] = i
+	}
+	return xs
+}
+
+func (s *balancer) selectTarget(xs []int) int {
+	switch s.strategy {
+	case Random:
+		return xs[s.intn(len(xs))]
+	case Weighted:
+		var sum int
+		for _, i := range xs {
+			sum += s.targets[i].weight
+		}
+		n := s.intn(sum)
+		for _, i := range xs {
+			if n < s.targets[i].weight {
+				return i
+			}
+			n -= s.targets[i].weight
+		}
+		return xs[len(xs)-1]
+	case LeastOutstanding:
+		// ties are broken by round robin
+		s.cursor++
+		best := -1
+		for j := range xs {
+			i := xs[(s.cursor+j)%len(xs)]
+			if best < 0 || s.targets[i].outstanding < s.targets[best].outstanding {
+				best = i
+			}
+		}
+		return best
+	default:
+		i := xs[s.cursor%len(xs)]
+		s.cursor++
+		return i
+	}
+}
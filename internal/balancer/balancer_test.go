@@ -0,0 +1,115 @@
+package balancer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/balancer"
+	"github.com/stretchr/testify/assert"
+)
+
+func next(b balancer.Balancer, n int, ok bool) []int {
+	xs := make([]int, n)
+	for i := 0; i < n; i++ {
+		x, done := b.Next()
+		done(ok)
+		xs[i] = x
+	}
+	return xs
+}
+
+func TestRoundRobin(t *testing.T) {
+	b := balancer.New(&balancer.Config{
+		Weights: []int{1, 1, 1},
+	})
+	assert.Equal(t, []int{0, 1, 2, 0, 1}, next(b, 5, true))
+}
+
+func TestRandom(t *testing.T) {
+	rnd := []int{2, 0, 1}
+	b := balancer.New(&balancer.Config{
+		Strategy: balancer.Random,
+		Weights:  []int{1, 1, 1},
+		Intn: func(n int) int {
+			assert.Equal(t, 3, n)
+			x := rnd[0]
+			rnd = rnd[1:]
+			return x
+		},
+	})
+	assert.Equal(t, []int{2, 0, 1}, next(b, 3, true))
+}
+
+func TestWeighted(t *testing.T) {
+	var rnd int
+	b := balancer.New(&balancer.Config{
+		Strategy: balancer.Weighted,
+		Weights:  []int{1, 3, 0},
+		Intn: func(n int) int {
+			assert.Equal(t, 5, n)
+			return rnd
+		},
+	})
+	for _, tc := range []struct {
+		rnd  int
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{3, 1},
+		{4, 2},
+	} {
+		rnd = tc.rnd
+		got, done := b.Next()
+		done(true)
+		assert.Equal(t, tc.want, got, "rnd %d", tc.rnd)
+	}
+}
+
+func TestLeastOutstanding(t *testing.T) {
+	b := balancer.New(&balancer.Config{
+		Strategy: balancer.LeastOutstanding,
+		Weights:  []int{1, 1, 1},
+	})
+	a, doneA := b.Next()
+	c, doneC := b.Next()
+	assert.NotEqual(t, a, c)
+	// the rest is the only one without outstanding requests
+	x, doneX := b.Next()
+	assert.NotEqual(t, a, x)
+	assert.NotEqual(t, c, x)
+	doneC(true)
+	y, doneY := b.Next()
+	assert.Equal(t, c, y)
+	doneA(true)
+	doneX(true)
+	doneY(true)
+}
+
+func TestEject(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := balancer.New(&balancer.Config{
+		Weights:       []int{1, 1},
+		MaxFails:      2,
+		EjectDuration: time.Second,
+		Now:           func() time.Time { return now },
+	})
+	report := func(target int, ok bool) {
+		x, done := b.Next()
+		assert.Equal(t, target, x)
+		done(ok)
+	}
+
+	report(0, false)
+	report(1, true)
+	report(0, false) // eject 0
+	assert.Equal(t, []int{1, 1, 1}, next(b, 3, true))
+
+	now = now.Add(time.Second)
+	assert.Equal(t, []int{0, 1}, next(b, 2, true))
+
+	t.Run("all ejected", func(t *testing.T) {
+		next(b, 4, false)
+		assert.Equal(t, []int{0, 1}, next(b, 2, true))
+	})
+}
@@ -0,0 +1,208 @@
+// Package breaker provides a circuit breaker.
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+type State int
+
+const (
+	// Closed lets requests pass.
+	Closed State = iota
+	// Open rejects requests.
+	Open
+	// HalfOpen lets a limited number of probe requests pass.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "CLOSED"
+	case Open:
+		return "OPEN"
+	case HalfOpen:
+		return "HALF_OPEN"
+	}
+	return "UNKNOWN"
+}
+
+type Result int
+
+const (
+	Success Result = iota
+	Failure
+	// Ignored is not counted, e.g. the request was not sent.
+	Ignored
+)
+
+type Config struct {
+	// FailureRate opens the breaker when the rate of failures in a window reaches it.
+	FailureRate float64
+	// MinRequests is the number of requests in a window to evaluate FailureRate.
+	MinRequests int
+	// Window is the duration to count requests, counts are reset per window.
+	Window time.Duration
+	// OpenDuration is the duration to reject requests before HalfOpen.
+	OpenDuration time.Duration
+	// Probes is the number of successful requests in HalfOpen to close the breaker.
+	Probes int
+	// Now returns the current time, time.Now if nil.
+	Now func() time.Time
+}
+
+type Stats struct {
+	State    State
+	Requests int
+	Failures int
+}
+
+type Breaker interface {
+	// Allow returns false if the request should be rejected,
+	// otherwise returns the function to report the result of the request.
+	Allow() (func(Result), bool)
+	Stats() Stats
+}
+
+func New(c *Config) Breaker {
+	now := c.Now
+	if now == nil {
+		now = time.Now
+	}
+	probes := c.Probes
+	if probes <= 0 {
+		probes = 1
+	}
+	return &breaker{
+		failureRate:  c.FailureRate,
+		minRequests:  c.MinRequests,
+		window:       c.Window,
+		openDuration: c.OpenDuration,
+		probes:       probes,
+		now:          now,
+		windowStart:  now(),
+	}
+}
+
+type breaker struct {
+	sync.Mutex
+	failureRate  float64
+	minRequests  int
+	window       time.Duration
+	openDuration time.Duration
+	probes       int
+	now          func() time.Time
+
+	state       State
+	windowStart time.Time
+	openedAt    time.Time
+	requests    int
+	failures    int
+	// probing is the number of probe requests in flight.
+	probing   int
+	succeeded int
+}
+
+func (s *breaker) Stats() Stats {
+	s.Lock()
+	defer s.Unlock()
+	s.update()
+	return Stats{
+		State:    s.state,
+		Requests: s.requests,
+		Failures: s.failures,
+	}
+}
+
+// update moves to the next window or state by time.
+func (s *breaker) update() {
+	now := s.now()
+	switch s.state {
+	case Closed:
+		if s.window > 0 && now.Sub(s.windowStart) >= s.window {
+			s.reset(now)
+		}
+	case Open:
+		if now.Sub(s.openedAt) >= s.openDuration {
+			s.state = HalfOpen
+			s.probing = 0
+			s.succeeded = 0
+		}
+	}
+}
+
+func (s *breaker) reset(now time.Time) {
+	s.windowStart = now
+	s.requests = 0
+	s.failures = 0
+}
+
+func (s *breaker) Allow() (func(Result), bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.update()
+	switch s.state {
+	case Open:
+		return nil, false
+	case HalfOpen:
+		if s.probing+s.succeeded >= s.probes {
+			return nil, false
+		}
+		s.probing++
+		return s.reporter(true), true
+	}
+	return s.reporter(false), true
+}
+
+func (s *breaker) reporter(probe bool) func(Result) {
+	var once sync.Once
+	return func(r Result) {
+		once.Do(func() {
+			s.Lock()
+			defer s.Unlock()
+			if probe {
+				s.reportProbe(r)
+				return
+			}
+			s.report(r)
+		})
+	}
+}
+
+func (s *breaker) reportProbe(r Result) {
+	if s.state != HalfOpen {
+		return
+	}
+	s.probing--
+	switch r {
+	case Success:
+		s.succeeded++
+		if s.succeeded >= s.probes {
+			s.state = Closed
+			s.reset(s.now())
+		}
+	case Failure:
+		s.open()
+	}
+}
+
+func (s *breaker) report(r Result) {
+	if s.state != Closed || r == Ignored {
+		return
+	}
+	s.requests++
+	if r == Failure {
+		s.failures++
+	}
+	if s.requests >= s.minRequests && float64(s.failures) >= s.failureRate*float64(s.requests) && s.failures > 0 {
+		s.open()
+	}
+}
+
+func (s *breaker) open() {
+	s.state = Open
+	s.openedAt = s.now()
+	s.reset(s.openedAt)
+}
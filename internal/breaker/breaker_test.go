@@ -0,0 +1,119 @@
+package breaker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/breaker"
+	"github.com/stretchr/testify/assert"
+)
+
+type clock struct {
+	t time.Time
+}
+
+func newClock() *clock { return &clock{t: time.Unix(0, 0)} }
+
+func (c *clock) now() time.Time      { return c.t }
+func (c *clock) add(d time.Duration) { c.t = c.t.Add(d) }
+
+func request(t *testing.T, b breaker.Breaker, r breaker.Result) {
+	t.Helper()
+	report, ok := b.Allow()
+	assert.True(t, ok)
+	report(r)
+}
+
+func TestBreaker(t *testing.T) {
+	newBreaker := func(c *clock) breaker.Breaker {
+		return breaker.New(&breaker.Config{
+			FailureRate:  0.5,
+			MinRequests:  4,
+			Window:       10 * time.Second,
+			OpenDuration: 5 * time.Second,
+			Probes:       2,
+			Now:          c.now,
+		})
+	}
+
+	t.Run("closed below min requests", func(t *testing.T) {
+		b := newBreaker(newClock())
+		for i := 0; i < 3; i++ {
+			request(t, b, breaker.Failure)
+		}
+		assert.Equal(t, breaker.Stats{
+			State:    breaker.Closed,
+			Requests: 3,
+			Failures: 3,
+		}, b.Stats())
+	})
+
+	t.Run("closed below failure rate", func(t *testing.T) {
+		b := newBreaker(newClock())
+		request(t, b, breaker.Failure)
+		for i := 0; i < 3; i++ {
+			request(t, b, breaker.Success)
+		}
+		request(t, b, breaker.Ignored)
+		assert.Equal(t, breaker.Stats{
+			State:    breaker.Closed,
+			Requests: 4,
+			Failures: 1,
+		}, b.Stats())
+	})
+
+	t.Run("window reset", func(t *testing.T) {
+		c := newClock()
+		b := newBreaker(c)
+		for i := 0; i < 3; i++ {
+			request(t, b, breaker.Failure)
+		}
+		c.add(10 * time.Second)
+		request(t, b, breaker.Failure)
+		assert.Equal(t, breaker.Stats{
+			State:    breaker.Closed,
+			Requests: 1,
+			Failures: 1,
+		}, b.Stats())
+	})
+
+	t.Run("open and close", func(t *testing.T) {
+		c := newClock()
+		b := newBreaker(c)
+		request(t, b, breaker.Success)
+		request(t, b, breaker.Success)
+		request(t, b, breaker.Failure)
+		request(t, b, breaker.Failure)
+		assert.Equal(t, breaker.Open, b.Stats().State)
+		_, ok := b.Allow()
+		assert.False(t, ok)
+
+		c.add(5 * time.Second)
+		assert.Equal(t, breaker.HalfOpen, b.Stats().State)
+		r1, ok := b.Allow()
+		assert.True(t, ok)
+		r2, ok := b.Allow()
+		assert.True(t, ok)
+		_, ok = b.Allow()
+		assert.False(t, ok, "probes are limited")
+		r1(breaker.Success)
+		r2(breaker.Success)
+		assert.Equal(t, breaker.Closed, b.Stats().State)
+	})
+
+	t.Run("reopen by probe", func(t *testing.T) {
+		c := newClock()
+		b := newBreaker(c)
+		for i := 0; i < 4; i++ {
+			request(t, b, breaker.Failure)
+		}
+		c.add(5 * time.Second)
+		request(t, b, breaker.Ignored)
+		request(t, b, breaker.Failure)
+		assert.Equal(t, breaker.Open, b.Stats().State)
+	})
+}
+
+func TestStateString(t *testing.T) {
+	assert.Equal(t, "HALF_OPEN", breaker.HalfOpen.String())
+}
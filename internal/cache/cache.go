@@ -0,0 +1,133 @@
+// Package cache provides a LRU cache with TTL.
+package cache
+
+import (
+	"container/list"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Cache interface {
+	// Get returns the value of the key if not expired.
+	Get(key string) (interface{}, bool)
+	// Set stores the value for ttl, evicts the least recently used entry if full.
+	Set(key string, value interface{}, ttl time.Duration)
+	Len() int
+}
+
+// New returns a new cache with at most size entries, no limit if size is 0.
+// now returns the current time, time.Now if nil.
+func New(size int, now func() time.Time) Cache {
+	if now == nil {
+		now = time.Now
+	}
+	return &cache{
+		size:    size,
+		now:     now,
+		list:    list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+type entry struct {
+	key       string
+	value     interface{}
+	expiredAt time.Time
+}
+
+type cache struct {
+	sync.Mutex
+	size    int
+	now     func() time.Time
+	list    *list.List
+	entries map[string]*list.Element
+}
+
+func (s *cache) Get(key string) (interface{}, bool) {
+	s.Lock()
+	defer s.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	x := e.Value.(*entry)
+	if !s.now().Before(x.expiredAt) {
+		s.remove(e)
+		return nil, false
+	}
+	s.list.MoveToFront(e)
+	return x.value, true
+}
+
+func (s *cache) Set(key string, value interface{}, ttl time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+	x := &entry{
+		key:       key,
+		value:     value,
+		expiredAt: s.now().Add(ttl),
+	}
+	if e, ok := s.entries[key]; ok {
+		e.Value = x
+		s.list.MoveToFront(e)
+		return
+	}
+	s.entries[key] = s.list.PushFront(x)
+	if s.size > 0 && s.list.Len() > s.size {
+		s.remove(s.list.Back())
+	}
+}
+
+func (s *cache) Len() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.list.Len()
+}
+
+func (s *cache) remove(e *list.Element) {
+	s.list.Remove(e)
+	delete(s.entries, e.Value.(*entry).key)
+}
+
+// Control is the directives of Cache-Control.
+type Control map[string]string
+
+// ParseControl parses the values of Cache-Control headers.
+func ParseControl(values []string) Control {
+	d := Control{}
+	for _, v := range values {
+		for _, x := range strings.Split(v, ",") {
+			x = strings.TrimSpace(x)
+			if x == "" {
+				continue
+			}
+			kv := strings.SplitN(x, "=", 2)
+			k := strings.ToLower(strings.TrimSpace(kv[0]))
+			if len(kv) == 2 {
+				d[k] = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+				continue
+			}
+			d[k] = ""
+		}
+	}
+	return d
+}
+
+func (s Control) Has(directive string) bool {
+	_, ok := s[directive]
+	return ok
+}
+
+// MaxAge returns s-maxage or max-age.
+func (s Control) MaxAge() (time.Duration, bool) {
+	for _, k := range []string{"s-maxage", "max-age"} {
+		if v, ok := s[k]; ok {
+			if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+				return time.Duration(n) * time.Second, true
+			}
+		}
+	}
+	return 0, false
+}
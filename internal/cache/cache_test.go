@@ -0,0 +1,106 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := cache.New(2, func() time.Time { return now })
+
+	t.Run("miss", func(t *testing.T) {
+		_, ok := c.Get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("hit", func(t *testing.T) {
+		c.Set("a", 1, time.Second)
+		v, ok := c.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		c.Set("a", 2, time.Second)
+		v, ok := c.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 2, v)
+		assert.Equal(t, 1, c.Len())
+	})
+
+	t.Run("evict least recently used", func(t *testing.T) {
+		c.Set("b", 3, time.Second)
+		_, _ = c.Get("a")
+		c.Set("c", 4, time.Second)
+		assert.Equal(t, 2, c.Len())
+		_, ok := c.Get("b")
+		assert.False(t, ok)
+		_, ok = c.Get("a")
+		assert.True(t, ok)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		now = now.Add(time.Second)
+		_, ok := c.Get("a")
+		assert.False(t, ok)
+		assert.Equal(t, 1, c.Len())
+	})
+}
+
+func TestParseControl(t *testing.T) {
+	for _, tc := range []*struct {
+		title   string
+		values  []string
+		want    cache.Control
+		maxAge  time.Duration
+		hasAge  bool
+		noStore bool
+	}{
+		{
+			title: "empty",
+			want:  cache.Control{},
+		},
+		{
+			title:  "max-age",
+			values: []string{"public, max-age=60"},
+			want: cache.Control{
+				"public":  "",
+				"max-age": "60",
+			},
+			maxAge: time.Minute,
+			hasAge: true,
+		},
+		{
+			title:  "s-maxage is preferred",
+			values: []string{"max-age=60", `S-MAXAGE="10", no-store`},
+			want: cache.Control{
+				"max-age":  "60",
+				"s-maxage": "10",
+				"no-store": "",
+			},
+			maxAge:  10 * time.Second,
+			hasAge:  true,
+			noStore: true,
+		},
+		{
+			title:  "invalid max-age",
+			values: []string{"max-age=x"},
+			want: cache.Control{
+				"max-age": "x",
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got := cache.ParseControl(tc.values)
+			assert.Equal(t, tc.want, got)
+			d, ok := got.MaxAge()
+			assert.Equal(t, tc.hasAge, ok)
+			assert.Equal(t, tc.maxAge, d)
+			assert.Equal(t, tc.noStore, got.Has("no-store"))
+		})
+	}
+}
@@ -19,8 +19,26 @@ const (
 	TypeCast
 	TypeCoerce
 	Jsonify
+	// UpstreamTimeout means the upstream did not respond in time.
+	UpstreamTimeout
+	// UpstreamConnection means the connection to the upstream failed.
+	UpstreamConnection
+	// UpstreamDNS means the host of the upstream was not resolved.
+	UpstreamDNS
+	// UpstreamError means the other failures of the upstream.
+	UpstreamError
 )
 
+// IsUpstream returns true if the code is of upstream failures.
+func (c Code) IsUpstream() bool {
+	switch c {
+	case UpstreamTimeout, UpstreamConnection, UpstreamDNS, UpstreamError:
+		return true
+	default:
+		return false
+	}
+}
+
 type Err struct {
 	err  error
 	msg  string
@@ -57,6 +75,36 @@ func Wrapf(err error, code Code, format string, v ...interface{}) *Err {
 
 func (s *Err) Code() Code { return s.code }
 
+// Unwrap returns the wrapped error.
+func (s *Err) Unwrap() error { return s.err }
+
+// Cause returns the innermost error that is not *Err, nil if not wrapped.
+func (s *Err) Cause() error {
+	t := s
+	for {
+		e, ok := t.err.(*Err)
+		if !ok {
+			return t.err
+		}
+		t = e
+	}
+}
+
+// Find returns the outermost *Err in the chain that satisfies f.
+func (s *Err) Find(f func(Code) bool) (*Err, bool) {
+	t := s
+	for {
+		if f(t.code) {
+			return t, true
+		}
+		e, ok := t.err.(*Err)
+		if !ok {
+			return nil, false
+		}
+		t = e
+	}
+}
+
 func (s *Err) Error() string {
 	t := s
 	for {
@@ -18,11 +18,15 @@ func _() {
 	_ = x[TypeCast-7]
 	_ = x[TypeCoerce-8]
 	_ = x[Jsonify-9]
+	_ = x[UpstreamTimeout-10]
+	_ = x[UpstreamConnection-11]
+	_ = x[UpstreamDNS-12]
+	_ = x[UpstreamError-13]
 }
 
-const _Code_name = "UnknownErrorInvalidArgumentOutOfRangeNotFoundInvalidValueInvalidSettingsHandlerTypeCastTypeCoerceJsonify"
+const _Code_name = "UnknownErrorInvalidArgumentOutOfRangeNotFoundInvalidValueInvalidSettingsHandlerTypeCastTypeCoerceJsonifyUpstreamTimeoutUpstreamConnectionUpstreamDNSUpstreamError"
 
-var _Code_index = [...]uint8{0, 12, 27, 37, 45, 57, 72, 79, 87, 97, 104}
+var _Code_index = [...]uint8{0, 12, 27, 37, 45, 57, 72, 79, 87, 97, 104, 119, 137, 148, 161}
 
 func (i Code) String() string {
 	if i < 0 || i >= Code(len(_Code_index)-1) {
@@ -0,0 +1,288 @@
+package expr
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+type node interface {
+	eval(env map[string]interface{}) (interface{}, error)
+}
+
+type literalNode struct {
+	v interface{}
+}
+
+func (s *literalNode) eval(_ map[string]interface{}) (interface{}, error) { return s.v, nil }
+
+type identNode struct {
+	name string
+}
+
+func (s *identNode) eval(env map[string]interface{}) (interface{}, error) {
+	v, ok := env[s.name]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "unknown name %s", s.name)
+	}
+	return v, nil
+}
+
+type listNode struct {
+	xs []node
+}
+
+func (s *listNode) eval(env map[string]interface{}) (interface{}, error) {
+	p := make([]interface{}, len(s.xs))
+	for i, x := range s.xs {
+		v, err := x.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		p[i] = v
+	}
+	return p, nil
+}
+
+type indexNode struct {
+	x     node
+	index node
+}
+
+func (s *indexNode) eval(env map[string]interface{}) (interface{}, error) {
+	x, err := s.x.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	i, err := s.index.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch x := x.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		k, ok := i.(string)
+		if !ok {
+			return nil, errors.Newf(errors.InvalidValue, "map index must be string but got %v", i)
+		}
+		return x[k], nil
+	case []interface{}:
+		n, ok := i.(float64)
+		if !ok {
+			return nil, errors.Newf(errors.InvalidValue, "list index must be number but got %v", i)
+		}
+		idx := int(n)
+		if idx < 0 {
+			idx += len(x)
+		}
+		if idx < 0 || idx >= len(x) {
+			return nil, nil
+		}
+		return x[idx], nil
+	case string:
+		n, ok := i.(float64)
+		if !ok {
+			return nil, errors.Newf(errors.InvalidValue, "string index must be number but got %v", i)
+		}
+		r := []rune(x)
+		idx := int(n)
+		if idx < 0 {
+			idx += len(r)
+		}
+		if idx < 0 || idx >= len(r) {
+			return nil, nil
+		}
+		return string(r[idx]), nil
+	}
+	return nil, errors.Newf(errors.InvalidValue, "cannot index %T %v", x, x)
+}
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+func (s *unaryNode) eval(env map[string]interface{}) (interface{}, error) {
+	x, err := s.x.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch s.op {
+	case "!":
+		return !truthy(x), nil
+	case "-":
+		n, err := toNumber(x)
+		if err != nil {
+			return nil, err
+		}
+		return -n, nil
+	}
+	return nil, errors.Newf(errors.UnknownError, "unary %s", s.op)
+}
+
+type condNode struct {
+	cond node
+	then node
+	els  node
+}
+
+func (s *condNode) eval(env map[string]interface{}) (interface{}, error) {
+	c, err := s.cond.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if truthy(c) {
+		return s.then.eval(env)
+	}
+	return s.els.eval(env)
+}
+
+type binaryNode struct {
+	op    string
+	left  node
+	right node
+}
+
+func (s *binaryNode) eval(env map[string]interface{}) (interface{}, error) {
+	left, err := s.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	// short circuit
+	switch s.op {
+	case "&&":
+		if !truthy(left) {
+			return false, nil
+		}
+		right, err := s.right.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		return truthy(right), nil
+	case "||":
+		if truthy(left) {
+			return true, nil
+		}
+		right, err := s.right.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		return truthy(right), nil
+	}
+
+	right, err := s.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch s.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "+":
+		ls, lok := left.(string)
+		rs, rok := right.(string)
+		if lok || rok {
+			if !lok {
+				ls = toString(left)
+			}
+			if !rok {
+				rs = toString(right)
+			}
+			return ls + rs, nil
+		}
+	case "<", "<=", ">", ">=":
+		if ls, ok := left.(string); ok {
+			if rs, ok := right.(string); ok {
+				return compare(s.op, compareString(ls, rs)), nil
+			}
+		}
+	}
+
+	l, err := toNumber(left)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "left of %s", s.op)
+	}
+	r, err := toNumber(right)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "right of %s", s.op)
+	}
+	switch s.op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, errors.New(errors.InvalidValue, "division by zero")
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, errors.New(errors.InvalidValue, "division by zero")
+		}
+		return math.Mod(l, r), nil
+	case "<", "<=", ">", ">=":
+		switch {
+		case l < r:
+			return compare(s.op, -1), nil
+		case l > r:
+			return compare(s.op, 1), nil
+		default:
+			return compare(s.op, 0), nil
+		}
+	}
+	return nil, errors.Newf(errors.UnknownError, "binary %s", s.op)
+}
+
+func compareString(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compare(op string, c int) bool {
+	switch op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
+
+func equal(a, b interface{}) bool { return reflect.DeepEqual(a, b) }
+
+type callNode struct {
+	name string
+	f    *function
+	args []node
+}
+
+func (s *callNode) eval(env map[string]interface{}) (interface{}, error) {
+	args := make([]interface{}, len(s.args))
+	for i, x := range s.args {
+		v, err := x.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	v, err := s.f.call(args)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "call %s", s.name)
+	}
+	return v, nil
+}
@@ -0,0 +1,46 @@
+// Package expr provides a small expression language over json values.
+//
+// Values are nil, bool, float64, string, []interface{} and map[string]interface{}.
+//
+//	len(body.items) > 0 && startsWith(lower(header["Content-Type"]), "application/json")
+//	query.page ? number(query.page) * 10 : 0
+package expr
+
+import (
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+// Program is a parsed expression.
+type Program interface {
+	// Eval evaluates the expression with the named values.
+	Eval(env map[string]interface{}) (interface{}, error)
+}
+
+// Parse parses an expression.
+func Parse(src string) (Program, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidSettings, "cannot parse %s", src)
+	}
+	n, err := (&parser{tokens: tokens}).parse()
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidSettings, "cannot parse %s", src)
+	}
+	return &program{
+		src:  src,
+		root: n,
+	}, nil
+}
+
+type program struct {
+	src  string
+	root node
+}
+
+func (s *program) Eval(env map[string]interface{}) (interface{}, error) {
+	v, err := s.root.eval(env)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot eval %s", s.src)
+	}
+	return v, nil
+}
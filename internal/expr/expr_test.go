@@ -0,0 +1,263 @@
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/internal/expr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []*struct {
+		title string
+		src   string
+	}{
+		{
+			title: "empty",
+			src:   "",
+		},
+		{
+			title: "unterminated string",
+			src:   `"abc`,
+		},
+		{
+			title: "unknown operator",
+			src:   "1 # 2",
+		},
+		{
+			title: "unknown function",
+			src:   "nothing(1)",
+		},
+		{
+			title: "wrong number of arguments",
+			src:   "upper(1, 2)",
+		},
+		{
+			title: "unclosed paren",
+			src:   "(1 + 2",
+		},
+		{
+			title: "trailing token",
+			src:   "1 2",
+		},
+		{
+			title: "incomplete ternary",
+			src:   "true ? 1",
+		},
+		{
+			title: "invalid member",
+			src:   "body.1",
+		},
+		{
+			title: "non-letter rune",
+			src:   "1 ∑ 2",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			_, err := expr.Parse(tc.src)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestEval(t *testing.T) {
+	env := map[string]interface{}{
+		"body": map[string]interface{}{
+			"name": "Alice",
+			"age":  20.0,
+			"tags": []interface{}{"a", "b", "c"},
+			"nest": map[string]interface{}{
+				"x": true,
+			},
+		},
+		"header": map[string]interface{}{
+			"Content-Type": "application/json",
+		},
+		"query": map[string]interface{}{
+			"page": "3",
+		},
+		"path": "/users/10",
+		"名前":   "utf8",
+	}
+
+	for _, tc := range []*struct {
+		title string
+		src   string
+		want  interface{}
+		isErr bool
+	}{
+		{
+			title: "number",
+			src:   "1.5",
+			want:  1.5,
+		},
+		{
+			title: "multibyte ident and space",
+			src:   "名前\u3000+ 'ü'",
+			want:  "utf8ü",
+		},
+		{
+			title: "string",
+			src:   `'it\'s'`,
+			want:  "it's",
+		},
+		{
+			title: "literals",
+			src:   "[true, false, null]",
+			want:  []interface{}{true, false, nil},
+		},
+		{
+			title: "precedence",
+			src:   "1 + 2 * 3 - 4 / 2",
+			want:  5.0,
+		},
+		{
+			title: "paren",
+			src:   "(1 + 2) * 3",
+			want:  9.0,
+		},
+		{
+			title: "mod",
+			src:   "7 % 3",
+			want:  1.0,
+		},
+		{
+			title: "unary",
+			src:   "-body.age + !false",
+			want:  -19.0,
+		},
+		{
+			title: "division by zero",
+			src:   "1 / 0",
+			isErr: true,
+		},
+		{
+			title: "concat",
+			src:   `body.name + " is " + body.age`,
+			want:  "Alice is 20",
+		},
+		{
+			title: "numeric string",
+			src:   "query.page * 10",
+			want:  30.0,
+		},
+		{
+			title: "not numeric string",
+			src:   "body.name * 10",
+			isErr: true,
+		},
+		{
+			title: "compare numbers",
+			src:   "body.age >= 20 && body.age < 21",
+			want:  true,
+		},
+		{
+			title: "compare strings",
+			src:   `"abc" < "abd"`,
+			want:  true,
+		},
+		{
+			title: "equal",
+			src:   `body.tags == ["a", "b", "c"] && body.name != "Bob"`,
+			want:  true,
+		},
+		{
+			title: "or short circuit",
+			src:   "true || 1 / 0",
+			want:  true,
+		},
+		{
+			title: "and short circuit",
+			src:   "false && 1 / 0",
+			want:  false,
+		},
+		{
+			title: "ternary",
+			src:   `body.nest.x ? "yes" : "no"`,
+			want:  "yes",
+		},
+		{
+			title: "index",
+			src:   `header["Content-Type"]`,
+			want:  "application/json",
+		},
+		{
+			title: "negative index",
+			src:   "body.tags[-1]",
+			want:  "c",
+		},
+		{
+			title: "index out of range",
+			src:   "body.tags[10]",
+			want:  nil,
+		},
+		{
+			title: "missing key",
+			src:   "body.missing.deep",
+			want:  nil,
+		},
+		{
+			title: "cannot index",
+			src:   "body.age.x",
+			isErr: true,
+		},
+		{
+			title: "unknown name",
+			src:   "cookie",
+			isErr: true,
+		},
+		{
+			title: "functions",
+			src:   `upper(body.name) + lower("X") + trim("  y ") + len(body.tags)`,
+			want:  "ALICExy3",
+		},
+		{
+			title: "split and join",
+			src:   `join(split(path, "/"), "-")`,
+			want:  "-users-10",
+		},
+		{
+			title: "contains",
+			src:   `contains(body.tags, "b") && contains(body.nest, "x") && contains(body.name, "li")`,
+			want:  true,
+		},
+		{
+			title: "substr",
+			src:   `substr(body.name, 1, -1)`,
+			want:  "lic",
+		},
+		{
+			title: "default",
+			src:   `default(query.size, 20)`,
+			want:  20.0,
+		},
+		{
+			title: "cast",
+			src:   `[string(1), number("2"), bool(""), int(3.7)]`,
+			want:  []interface{}{"1", 2.0, false, 3.0},
+		},
+		{
+			title: "min max",
+			src:   `[min(3, 1, 2), max(3, 1, 2)]`,
+			want:  []interface{}{1.0, 3.0},
+		},
+		{
+			title: "keys",
+			src:   `keys(body.nest)`,
+			want:  []interface{}{"x"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			p, err := expr.Parse(tc.src)
+			assert.Nil(t, err)
+			got, err := p.Eval(env)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "", cmp.Diff(tc.want, got))
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+)
+
+type function struct {
+	minArgs int
+	// -1 means variadic
+	maxArgs int
+	call    func(args []interface{}) (interface{}, error)
+}
+
+func newFunction(minArgs, maxArgs int, call func(args []interface{}) (interface{}, error)) *function {
+	return &function{
+		minArgs: minArgs,
+		maxArgs: maxArgs,
+		call:    call,
+	}
+}
+
+func stringFunction(f func(string) interface{}) *function {
+	return newFunction(1, 1, func(args []interface{}) (interface{}, error) {
+		return f(toString(args[0])), nil
+	})
+}
+
+func stringsFunction(f func(string, string) interface{}) *function {
+	return newFunction(2, 2, func(args []interface{}) (interface{}, error) {
+		return f(toString(args[0]), toString(args[1])), nil
+	})
+}
+
+func numberFunction(f func(float64) float64) *function {
+	return newFunction(1, 1, func(args []interface{}) (interface{}, error) {
+		x, err := toNumber(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return f(x), nil
+	})
+}
+
+func numbersFunction(f func(float64, float64) float64) *function {
+	return newFunction(1, -1, func(args []interface{}) (interface{}, error) {
+		acc, err := toNumber(args[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range args[1:] {
+			x, err := toNumber(a)
+			if err != nil {
+				return nil, err
+			}
+			acc = f(acc, x)
+		}
+		return acc, nil
+	})
+}
+
+var funcs = map[string]*function{
+	"len": newFunction(1, 1, func(args []interface{}) (interface{}, error) {
+		switch x := args[0].(type) {
+		case nil:
+			return 0.0, nil
+		case string:
+			return float64(len([]rune(x))), nil
+		case []interface{}:
+			return float64(len(x)), nil
+		case map[string]interface{}:
+			return float64(len(x)), nil
+		}
+		return nil, errors.Newf(errors.InvalidValue, "no length %v", args[0])
+	}),
+	"upper":      stringFunction(func(s string) interface{} { return strings.ToUpper(s) }),
+	"lower":      stringFunction(func(s string) interface{} { return strings.ToLower(s) }),
+	"trim":       stringFunction(func(s string) interface{} { return strings.TrimSpace(s) }),
+	"startsWith": stringsFunction(func(s, x string) interface{} { return strings.HasPrefix(s, x) }),
+	"endsWith":   stringsFunction(func(s, x string) interface{} { return strings.HasSuffix(s, x) }),
+	"split": stringsFunction(func(s, sep string) interface{} {
+		xs := strings.Split(s, sep)
+		p := make([]interface{}, len(xs))
+		for i, x := range xs {
+			p[i] = x
+		}
+		return p
+	}),
+	"contains": newFunction(2, 2, func(args []interface{}) (interface{}, error) {
+		switch x := args[0].(type) {
+		case []interface{}:
+			for _, v := range x {
+				if equal(v, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case map[string]interface{}:
+			_, ok := x[toString(args[1])]
+			return ok, nil
+		}
+		return strings.Contains(toString(args[0]), toString(args[1])), nil
+	}),
+	"replace": newFunction(3, 3, func(args []interface{}) (interface{}, error) {
+		return strings.ReplaceAll(toString(args[0]), toString(args[1]), toString(args[2])), nil
+	}),
+	"join": newFunction(1, 2, func(args []interface{}) (interface{}, error) {
+		xs, ok := args[0].([]interface{})
+		if !ok {
+			return nil, errors.Newf(errors.InvalidValue, "not list %v", args[0])
+		}
+		var sep string
+		if len(args) > 1 {
+			sep = toString(args[1])
+		}
+		ss := make([]string, len(xs))
+		for i, x := range xs {
+			ss[i] = toString(x)
+		}
+		return strings.Join(ss, sep), nil
+	}),
+	"substr": newFunction(2, 3, func(args []interface{}) (interface{}, error) {
+		r := []rune(toString(args[0]))
+		start, err := toNumber(args[1])
+		if err != nil {
+			return nil, err
+		}
+		end := float64(len(r))
+		if len(args) > 2 {
+			if end, err = toNumber(args[2]); err != nil {
+				return nil, err
+			}
+		}
+		i, j := clamp(int(start), len(r)), clamp(int(end), len(r))
+		if i > j {
+			return "", nil
+		}
+		return string(r[i:j]), nil
+	}),
+	"keys": newFunction(1, 1, func(args []interface{}) (interface{}, error) {
+		m, ok := args[0].(map[string]interface{})
+		if !ok {
+			return nil, errors.Newf(errors.InvalidValue, "not map %v", args[0])
+		}
+		ks := make([]string, 0, len(m))
+		for k := range m {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+		p := make([]interface{}, len(ks))
+		for i, k := range ks {
+			p[i] = k
+		}
+		return p, nil
+	}),
+	"default": newFunction(2, 2, func(args []interface{}) (interface{}, error) {
+		if args[0] == nil {
+			return args[1], nil
+		}
+		return args[0], nil
+	}),
+	"string": newFunction(1, 1, func(args []interface{}) (interface{}, error) {
+		return toString(args[0]), nil
+	}),
+	"number": newFunction(1, 1, func(args []interface{}) (interface{}, error) {
+		return toNumber(args[0])
+	}),
+	"bool": newFunction(1, 1, func(args []interface{}) (interface{}, error) {
+		return truthy(args[0]), nil
+	}),
+	"int":   numberFunction(math.Trunc),
+	"abs":   numberFunction(math.Abs),
+	"floor": numberFunction(math.Floor),
+	"ceil":  numberFunction(math.Ceil),
+	"round": numberFunction(math.Round),
+	"min":   numbersFunction(math.Min),
+	"max":   numbersFunction(math.Max),
+}
+
+func clamp(i, n int) int {
+	if i < 0 {
+		i += n
+	}
+	if i < 0 {
+		return 0
+	}
+	if i > n {
+		return n
+	}
+	return i
+}
+
+func truthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return true
+}
+
+func toNumber(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case float64:
+		return v, nil
+	case string:
+		x, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, errors.TypeCast, "not number %q", v)
+		}
+		return x, nil
+	}
+	return 0, errors.Newf(errors.TypeCast, "not number %v", v)
+}
+
+func toString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case float64:
+		if util.IsInt(v) {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return fmt.Sprint(v)
+	case bool:
+		return fmt.Sprint(v)
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,142 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	typ tokenType
+	// raw text for idents and ops, unquoted text for strings
+	text string
+	num  float64
+	pos  int
+}
+
+var operators = []string{
+	"==", "!=", "<=", ">=", "&&", "||",
+	"+", "-", "*", "/", "%", "<", ">", "!",
+	"(", ")", "[", "]", ".", ",", "?", ":",
+}
+
+func lex(src string) ([]*token, error) {
+	var (
+		tokens []*token
+		i      int
+	)
+	for i < len(src) {
+		c, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(src) && (src[j] >= '0' && src[j] <= '9' || src[j] == '.') {
+				j++
+			}
+			n, err := strconv.ParseFloat(src[i:j], 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidSettings, "invalid number at %d", i)
+			}
+			tokens = append(tokens, &token{
+				typ:  tokenNumber,
+				text: src[i:j],
+				num:  n,
+				pos:  i,
+			})
+			i = j
+		case c == '"' || c == '\'':
+			s, n, err := lexString(src[i:])
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidSettings, "invalid string at %d", i)
+			}
+			tokens = append(tokens, &token{
+				typ:  tokenString,
+				text: s,
+				pos:  i,
+			})
+			i += n
+		case c == '_' || unicode.IsLetter(c):
+			j := i
+			for j < len(src) {
+				r, n := utf8.DecodeRuneInString(src[j:])
+				if !(r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)) {
+					break
+				}
+				j += n
+			}
+			tokens = append(tokens, &token{
+				typ:  tokenIdent,
+				text: src[i:j],
+				pos:  i,
+			})
+			i = j
+		default:
+			op := func() string {
+				for _, x := range operators {
+					if strings.HasPrefix(src[i:], x) {
+						return x
+					}
+				}
+				return ""
+			}()
+			if op == "" {
+				return nil, errors.Newf(errors.InvalidSettings, "unexpected %q at %d", c, i)
+			}
+			tokens = append(tokens, &token{
+				typ:  tokenOp,
+				text: op,
+				pos:  i,
+			})
+			i += len(op)
+		}
+	}
+	return append(tokens, &token{
+		typ: tokenEOF,
+		pos: len(src),
+	}), nil
+}
+
+// lexString reads a quoted string and returns the unquoted string and the consumed length.
+func lexString(src string) (string, int, error) {
+	var (
+		quote = src[0]
+		b     strings.Builder
+	)
+	for i := 1; i < len(src); i++ {
+		switch src[i] {
+		case quote:
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+1 >= len(src) {
+				return "", 0, errors.New(errors.InvalidSettings, "unterminated escape")
+			}
+			i++
+			switch src[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(src[i])
+			}
+		default:
+			b.WriteByte(src[i])
+		}
+	}
+	return "", 0, errors.New(errors.InvalidSettings, "unterminated string")
+}
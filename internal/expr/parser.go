@@ -0,0 +1,260 @@
+package expr
+
+import (
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+// Grammar:
+//
+//	expr    = or [ "?" expr ":" expr ]
+//	or      = and { "||" and }
+//	and     = eq { "&&" eq }
+//	eq      = cmp { ( "==" | "!=" ) cmp }
+//	cmp     = add { ( "<" | "<=" | ">" | ">=" ) add }
+//	add     = mul { ( "+" | "-" ) mul }
+//	mul     = unary { ( "*" | "/" | "%" ) unary }
+//	unary   = ( "-" | "!" ) unary | postfix
+//	postfix = primary { "." IDENT | "[" expr "]" }
+//	primary = NUMBER | STRING | "true" | "false" | "null"
+//	        | IDENT "(" [ expr { "," expr } ] ")" | IDENT | "(" expr ")" | "[" [ expr { "," expr } ] "]"
+type parser struct {
+	tokens []*token
+	pos    int
+}
+
+func (s *parser) peek() *token { return s.tokens[s.pos] }
+
+func (s *parser) next() *token {
+	t := s.tokens[s.pos]
+	if t.typ != tokenEOF {
+		s.pos++
+	}
+	return t
+}
+
+func (s *parser) isOp(ops ...string) bool {
+	t := s.peek()
+	if t.typ != tokenOp {
+		return false
+	}
+	for _, x := range ops {
+		if t.text == x {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *parser) expect(op string) error {
+	if !s.isOp(op) {
+		t := s.peek()
+		return errors.Newf(errors.InvalidSettings, "expected %s but got %q at %d", op, t.text, t.pos)
+	}
+	s.next()
+	return nil
+}
+
+func (s *parser) parse() (node, error) {
+	n, err := s.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if t := s.peek(); t.typ != tokenEOF {
+		return nil, errors.Newf(errors.InvalidSettings, "unexpected %q at %d", t.text, t.pos)
+	}
+	return n, nil
+}
+
+func (s *parser) parseExpr() (node, error) {
+	cond, err := s.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isOp("?") {
+		return cond, nil
+	}
+	s.next()
+	then, err := s.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.expect(":"); err != nil {
+		return nil, err
+	}
+	els, err := s.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &condNode{
+		cond: cond,
+		then: then,
+		els:  els,
+	}, nil
+}
+
+// binaryLevels lists binary operators from the lowest precedence.
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (s *parser) parseBinary(level int) (node, error) {
+	if level >= len(binaryLevels) {
+		return s.parseUnary()
+	}
+	left, err := s.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for s.isOp(binaryLevels[level]...) {
+		op := s.next().text
+		right, err := s.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{
+			op:    op,
+			left:  left,
+			right: right,
+		}
+	}
+	return left, nil
+}
+
+func (s *parser) parseUnary() (node, error) {
+	if s.isOp("-", "!") {
+		op := s.next().text
+		x, err := s.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{
+			op: op,
+			x:  x,
+		}, nil
+	}
+	return s.parsePostfix()
+}
+
+func (s *parser) parsePostfix() (node, error) {
+	x, err := s.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case s.isOp("."):
+			s.next()
+			t := s.next()
+			if t.typ != tokenIdent {
+				return nil, errors.Newf(errors.InvalidSettings, "expected name but got %q at %d", t.text, t.pos)
+			}
+			x = &indexNode{
+				x:     x,
+				index: &literalNode{v: t.text},
+			}
+		case s.isOp("["):
+			s.next()
+			i, err := s.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := s.expect("]"); err != nil {
+				return nil, err
+			}
+			x = &indexNode{
+				x:     x,
+				index: i,
+			}
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (s *parser) parseList(end string) ([]node, error) {
+	var xs []node
+	if s.isOp(end) {
+		s.next()
+		return xs, nil
+	}
+	for {
+		x, err := s.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		xs = append(xs, x)
+		if s.isOp(end) {
+			s.next()
+			return xs, nil
+		}
+		if err := s.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *parser) parsePrimary() (node, error) {
+	t := s.next()
+	switch t.typ {
+	case tokenNumber:
+		return &literalNode{v: t.num}, nil
+	case tokenString:
+		return &literalNode{v: t.text}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true":
+			return &literalNode{v: true}, nil
+		case "false":
+			return &literalNode{v: false}, nil
+		case "null":
+			return &literalNode{v: nil}, nil
+		}
+		if !s.isOp("(") {
+			return &identNode{name: t.text}, nil
+		}
+		s.next()
+		f, ok := funcs[t.text]
+		if !ok {
+			return nil, errors.Newf(errors.InvalidSettings, "unknown function %s at %d", t.text, t.pos)
+		}
+		args, err := s.parseList(")")
+		if err != nil {
+			return nil, err
+		}
+		if len(args) < f.minArgs || (f.maxArgs >= 0 && len(args) > f.maxArgs) {
+			return nil, errors.Newf(errors.InvalidSettings, "wrong number of arguments to %s at %d", t.text, t.pos)
+		}
+		return &callNode{
+			name: t.text,
+			f:    f,
+			args: args,
+		}, nil
+	case tokenOp:
+		switch t.text {
+		case "(":
+			x, err := s.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := s.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		case "[":
+			xs, err := s.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{xs: xs}, nil
+		}
+	}
+	if t.typ == tokenEOF {
+		return nil, errors.New(errors.InvalidSettings, "unexpected end of expression")
+	}
+	return nil, errors.Newf(errors.InvalidSettings, "unexpected %q at %d", t.text, t.pos)
+}
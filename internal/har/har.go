@@ -0,0 +1,121 @@
+// Package har reads HTTP Archive (HAR) files.
+package har
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+// HAR is the root of a HAR file.
+type HAR struct {
+	Log *Log `json:"log"`
+}
+
+type Log struct {
+	Entries []*Entry `json:"entries"`
+}
+
+type Entry struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+type Request struct {
+	Method      string       `json:"method"`
+	URL         string       `json:"url"`
+	Headers     []*NameValue `json:"headers"`
+	QueryString []*NameValue `json:"queryString"`
+	PostData    *PostData    `json:"postData,omitempty"`
+}
+
+type Response struct {
+	Status  int          `json:"status"`
+	Headers []*NameValue `json:"headers"`
+	Content *Content     `json:"content"`
+}
+
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type Content struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	// Encoding is base64 if Text is encoded.
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// Parse parses a HAR file.
+func Parse(b []byte) (*HAR, error) {
+	var h HAR
+	if err := json.Unmarshal(b, &h); err != nil {
+		return nil, errors.Wrap(err, errors.InvalidValue, "parse har")
+	}
+	if h.Log == nil {
+		return nil, errors.New(errors.InvalidValue, "har has no log")
+	}
+	return &h, nil
+}
+
+// HTTPRequest returns the request of the entry and its body.
+func (s *Entry) HTTPRequest() (*http.Request, []byte, error) {
+	if s.Request == nil {
+		return nil, nil, errors.New(errors.InvalidValue, "entry has no request")
+	}
+	var body []byte
+	if s.Request.PostData != nil {
+		body = []byte(s.Request.PostData.Text)
+	}
+	r, err := http.NewRequest(s.Request.Method, s.Request.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, errors.InvalidValue, "invalid request %s %s", s.Request.Method, s.Request.URL)
+	}
+	for _, h := range s.Request.Headers {
+		// pseudo headers of http2
+		if strings.HasPrefix(h.Name, ":") {
+			continue
+		}
+		r.Header.Add(h.Name, h.Value)
+	}
+	if s.Request.PostData != nil && s.Request.PostData.MimeType != "" && r.Header.Get("Content-Type") == "" {
+		r.Header.Set("Content-Type", s.Request.PostData.MimeType)
+	}
+	return r, body, nil
+}
+
+// HTTPResponse returns the status, the headers and the decoded body of the response of the entry.
+func (s *Entry) HTTPResponse() (int, http.Header, []byte, error) {
+	if s.Response == nil {
+		return 0, nil, nil, errors.New(errors.InvalidValue, "entry has no response")
+	}
+	header := http.Header{}
+	for _, h := range s.Response.Headers {
+		header.Add(h.Name, h.Value)
+	}
+	var body []byte
+	if c := s.Response.Content; c != nil {
+		body = []byte(c.Text)
+		if c.Encoding == "base64" {
+			b, err := base64.StdEncoding.DecodeString(c.Text)
+			if err != nil {
+				return 0, nil, nil, errors.Wrap(err, errors.InvalidValue, "decode response content")
+			}
+			body = b
+		}
+		if c.MimeType != "" && header.Get("Content-Type") == "" {
+			header.Set("Content-Type", c.MimeType)
+		}
+	}
+	return s.Response.Status, header, body, nil
+}
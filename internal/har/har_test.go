@@ -0,0 +1,99 @@
+package har_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/internal/har"
+	"github.com/stretchr/testify/assert"
+)
+
+const archive = `{"log":{"version":"1.2","entries":[
+{
+  "request":{
+    "method":"POST",
+    "url":"https://example.com/users?id=1",
+    "headers":[{"name":":authority","value":"example.com"},{"name":"Accept","value":"application/json"}],
+    "queryString":[{"name":"id","value":"1"}],
+    "postData":{"mimeType":"application/json","text":"{\"name\":\"alice\"}"}
+  },
+  "response":{
+    "status":201,
+    "headers":[{"name":"X-Total","value":"1"}],
+    "content":{"mimeType":"application/json","text":"{\"id\":1}"}
+  }
+},
+{
+  "request":{"method":"GET","url":"https://example.com/logo.txt","headers":[],"queryString":[]},
+  "response":{"status":200,"headers":[],"content":{"mimeType":"text/plain","text":"aGVsbG8=","encoding":"base64"}}
+},
+{
+  "request":{"method":"GET","url":"https://example.com/broken","headers":[],"queryString":[]},
+  "response":{"status":200,"headers":[],"content":{"text":"!!","encoding":"base64"}}
+},
+{
+  "request":{"method":"GET","url":"%","headers":[],"queryString":[]}
+}
+]}}`
+
+func TestParse(t *testing.T) {
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := har.Parse([]byte(`{`))
+		assert.NotNil(t, err)
+	})
+	t.Run("no log", func(t *testing.T) {
+		_, err := har.Parse([]byte(`{}`))
+		assert.NotNil(t, err)
+	})
+
+	h, err := har.Parse([]byte(archive))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(h.Log.Entries))
+
+	t.Run("request", func(t *testing.T) {
+		r, body, err := h.Log.Entries[0].HTTPRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/users", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("id"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "", r.Header.Get(":authority"))
+		assert.Equal(t, `{"name":"alice"}`, string(body))
+		b, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, body, b)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, _, err := h.Log.Entries[3].HTTPRequest()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("response", func(t *testing.T) {
+		status, header, body, err := h.Log.Entries[0].HTTPResponse()
+		assert.Nil(t, err)
+		assert.Equal(t, 201, status)
+		assert.Equal(t, "1", header.Get("X-Total"))
+		assert.Equal(t, "application/json", header.Get("Content-Type"))
+		assert.Equal(t, `{"id":1}`, string(body))
+	})
+
+	t.Run("base64 response", func(t *testing.T) {
+		_, header, body, err := h.Log.Entries[1].HTTPResponse()
+		assert.Nil(t, err)
+		assert.Equal(t, "text/plain", header.Get("Content-Type"))
+		assert.Equal(t, "hello", string(body))
+	})
+
+	t.Run("invalid base64 response", func(t *testing.T) {
+		_, _, _, err := h.Log.Entries[2].HTTPResponse()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("no response", func(t *testing.T) {
+		_, _, _, err := h.Log.Entries[3].HTTPResponse()
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,552 @@
+// Package jsonschema validates values against JSON Schema.
+//
+// The keywords are type, enum, const, properties, required, additionalProperties,
+// items, minItems, maxItems, uniqueItems, minProperties, maxProperties,
+// minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf,
+// minLength, maxLength, pattern, format, allOf, anyOf, oneOf, not, nullable and $ref to the same document.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Violation is a failure of the validation.
+type Violation struct {
+	// Path is the JSON pointer to the invalid value, e.g. /items/0/name.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+type Schema interface {
+	// Validate returns the violations of v, v is a value decoded by encoding/json.
+	Validate(v interface{}) []*Violation
+	// Object returns the object of the multi-valued strings, e.g. queries and headers.
+	// The values of the array properties are all the values, the others are the first values,
+	// converted into the types of the property schemas.
+	Object(values map[string][]string) map[string]interface{}
+}
+
+// New returns a schema of the schema value, the root resolves $ref, the schema itself if nil.
+func New(schema, root interface{}) (Schema, error) {
+	if root == nil {
+		root = schema
+	}
+	s := &validator{
+		schema:   normalize(schema),
+		root:     normalize(root),
+		patterns: map[string]*regexp.Regexp{},
+	}
+	if err := s.compile(s.schema); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Parse parses a schema in json or yaml.
+func Parse(b []byte) (Schema, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, errors.Wrap(err, errors.InvalidValue, "parse json schema")
+	}
+	return New(v, nil)
+}
+
+// normalize converts the value into the form of encoding/json.
+func normalize(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var x interface{}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return v
+	}
+	return x
+}
+
+type validator struct {
+	schema   interface{}
+	root     interface{}
+	patterns map[string]*regexp.Regexp
+}
+
+// compile checks the patterns and the refs of the schema.
+func (s *validator) compile(schema interface{}) error {
+	switch x := schema.(type) {
+	case map[string]interface{}:
+		if p, ok := x["pattern"].(string); ok {
+			if _, ok := s.patterns[p]; !ok {
+				r, err := regexp.Compile(p)
+				if err != nil {
+					return errors.Wrapf(err, errors.InvalidValue, "pattern %s", p)
+				}
+				s.patterns[p] = r
+			}
+		}
+		if ref, ok := x["$ref"].(string); ok {
+			if _, err := s.resolve(ref); err != nil {
+				return err
+			}
+		}
+		for k, v := range x {
+			// enum, const and examples are values
+			switch k {
+			case "enum", "const", "example", "examples", "default":
+				continue
+			}
+			if err := s.compile(v); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, v := range x {
+			if err := s.compile(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolve returns the schema of the JSON pointer in the root, e.g. #/$defs/user.
+func (s *validator) resolve(ref string) (interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, errors.Newf(errors.InvalidValue, "unsupported ref %s", ref)
+	}
+	v := s.root
+	for _, k := range strings.Split(strings.TrimPrefix(strings.TrimPrefix(ref, "#"), "/"), "/") {
+		if k == "" {
+			continue
+		}
+		k = strings.ReplaceAll(strings.ReplaceAll(k, "~1", "/"), "~0", "~")
+		switch x := v.(type) {
+		case map[string]interface{}:
+			e, ok := x[k]
+			if !ok {
+				return nil, errors.Newf(errors.NotFound, "ref %s", ref)
+			}
+			v = e
+		case []interface{}:
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(x) {
+				return nil, errors.Newf(errors.NotFound, "ref %s", ref)
+			}
+			v = x[i]
+		default:
+			return nil, errors.Newf(errors.NotFound, "ref %s", ref)
+		}
+	}
+	return v, nil
+}
+
+func (s *validator) Validate(v interface{}) []*Violation {
+	var vs []*Violation
+	s.validate(s.schema, normalize(v), "", &vs, 0)
+	return vs
+}
+
+func (s *validator) Object(values map[string][]string) map[string]interface{} {
+	props, _ := s.deref(s.schema)["properties"].(map[string]interface{})
+	m := make(map[string]interface{}, len(values))
+	for k, vs := range values {
+		if len(vs) == 0 {
+			continue
+		}
+		p := s.deref(props[k])
+		ts := types(p)
+		if !contains(ts, "array") {
+			m[k] = coerceTypes(vs[0], ts)
+			continue
+		}
+		its := types(s.deref(p["items"]))
+		xs := make([]interface{}, len(vs))
+		for i, v := range vs {
+			xs[i] = coerceTypes(v, its)
+		}
+		m[k] = xs
+	}
+	return m
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
+
+// coerceTypes converts v into the first convertible type, v itself if not converted.
+func coerceTypes(v string, ts []string) interface{} {
+	for _, t := range ts {
+		if c, ok := coerce(v, t); ok {
+			return c
+		}
+	}
+	return v
+}
+
+func coerce(v, typ string) (interface{}, bool) {
+	switch typ {
+	case "integer", "number":
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	case "boolean":
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	case "string":
+		return v, true
+	}
+	return nil, false
+}
+
+// deref resolves $ref of the schema, the schema itself if not resolved.
+func (s *validator) deref(schema interface{}) map[string]interface{} {
+	for i := 0; i < 32; i++ {
+		m, ok := schema.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+		x, err := s.resolve(ref)
+		if err != nil {
+			return m
+		}
+		schema = x
+	}
+	return nil
+}
+
+func types(schema map[string]interface{}) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		xs := make([]string, 0, len(t))
+		for _, x := range t {
+			if s, ok := x.(string); ok {
+				xs = append(xs, s)
+			}
+		}
+		return xs
+	}
+	return nil
+}
+
+func typeOf(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if x == math.Trunc(x) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func matchType(v interface{}, t string) bool {
+	x := typeOf(v)
+	return x == t || (t == "number" && x == "integer")
+}
+
+func escape(k string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(k, "~", "~0"), "/", "~1")
+}
+
+func number(v interface{}) (float64, bool) {
+	x, ok := v.(float64)
+	return x, ok
+}
+
+func integer(v interface{}) (int, bool) {
+	x, ok := v.(float64)
+	return int(x), ok
+}
+
+func (s *validator) validate(schema, v interface{}, path string, vs *[]*Violation, depth int) {
+	add := func(format string, a ...interface{}) {
+		p := path
+		if p == "" {
+			p = "/"
+		}
+		*vs = append(*vs, &Violation{
+			Path:    p,
+			Message: fmt.Sprintf(format, a...),
+		})
+	}
+	if depth > 64 {
+		add("too deep schema")
+		return
+	}
+	switch x := schema.(type) {
+	case bool:
+		if !x {
+			add("not allowed")
+		}
+		return
+	case map[string]interface{}:
+	default:
+		return
+	}
+	m := schema.(map[string]interface{})
+	if ref, ok := m["$ref"].(string); ok {
+		x, err := s.resolve(ref)
+		if err != nil {
+			add("%v", err)
+			return
+		}
+		s.validate(x, v, path, vs, depth+1)
+	}
+	if v == nil && m["nullable"] == true {
+		return
+	}
+
+	if ts := types(m); len(ts) > 0 {
+		var ok bool
+		for _, t := range ts {
+			if matchType(v, t) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			add("type should be %s, got %s", strings.Join(ts, " or "), typeOf(v))
+			return
+		}
+	}
+	if xs, ok := m["enum"].([]interface{}); ok {
+		var found bool
+		for _, x := range xs {
+			if reflect.DeepEqual(x, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			add("should be one of %s", jsonString(xs))
+		}
+	}
+	if x, ok := m["const"]; ok && !reflect.DeepEqual(x, v) {
+		add("should be %s", jsonString(x))
+	}
+
+	switch x := v.(type) {
+	case float64:
+		s.validateNumber(m, x, add)
+	case string:
+		s.validateString(m, x, add)
+	case []interface{}:
+		s.validateArray(m, x, path, vs, depth, add)
+	case map[string]interface{}:
+		s.validateObject(m, x, path, vs, depth, add)
+	}
+
+	if xs, ok := m["allOf"].([]interface{}); ok {
+		for _, x := range xs {
+			s.validate(x, v, path, vs, depth+1)
+		}
+	}
+	if xs, ok := m["anyOf"].([]interface{}); ok {
+		var matched bool
+		for _, x := range xs {
+			if len(s.sub(x, v, path, depth)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			add("should match any of anyOf")
+		}
+	}
+	if xs, ok := m["oneOf"].([]interface{}); ok {
+		var n int
+		for _, x := range xs {
+			if len(s.sub(x, v, path, depth)) == 0 {
+				n++
+			}
+		}
+		if n != 1 {
+			add("should match exactly one of oneOf, matched %d", n)
+		}
+	}
+	if x, ok := m["not"]; ok && len(s.sub(x, v, path, depth)) == 0 {
+		add("should not match not")
+	}
+}
+
+// sub returns the violations of the subschema without adding them.
+func (s *validator) sub(schema, v interface{}, path string, depth int) []*Violation {
+	var vs []*Violation
+	s.validate(schema, v, path, &vs, depth+1)
+	return vs
+}
+
+func (s *validator) validateNumber(m map[string]interface{}, v float64, add func(string, ...interface{})) {
+	if x, ok := number(m["minimum"]); ok {
+		// exclusiveMinimum is boolean in draft 4 and OpenAPI 3.0
+		if m["exclusiveMinimum"] == true {
+			if v <= x {
+				add("should be > %v", x)
+			}
+		} else if v < x {
+			add("should be >= %v", x)
+		}
+	}
+	if x, ok := number(m["maximum"]); ok {
+		if m["exclusiveMaximum"] == true {
+			if v >= x {
+				add("should be < %v", x)
+			}
+		} else if v > x {
+			add("should be <= %v", x)
+		}
+	}
+	if x, ok := number(m["exclusiveMinimum"]); ok && v <= x {
+		add("should be > %v", x)
+	}
+	if x, ok := number(m["exclusiveMaximum"]); ok && v >= x {
+		add("should be < %v", x)
+	}
+	if x, ok := number(m["multipleOf"]); ok && x > 0 {
+		if q := v / x; math.Abs(q-math.Round(q)) > 1e-9 {
+			add("should be a multiple of %v", x)
+		}
+	}
+}
+
+func (s *validator) validateString(m map[string]interface{}, v string, add func(string, ...interface{})) {
+	n := utf8.RuneCountInString(v)
+	if x, ok := integer(m["minLength"]); ok && n < x {
+		add("length should be >= %d", x)
+	}
+	if x, ok := integer(m["maxLength"]); ok && n > x {
+		add("length should be <= %d", x)
+	}
+	if p, ok := m["pattern"].(string); ok {
+		if r, ok := s.patterns[p]; ok && !r.MatchString(v) {
+			add("should match %s", p)
+		}
+	}
+	if f, ok := m["format"].(string); ok && !validFormat(f, v) {
+		add("should be %s format", f)
+	}
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validFormat returns true if v is the format, unknown formats are valid.
+func validFormat(format, v string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, v)
+		return err == nil
+	case "date":
+		_, err := time.Parse("2006-01-02", v)
+		return err == nil
+	case "email":
+		a, err := mail.ParseAddress(v)
+		return err == nil && a.Address == v
+	case "uuid":
+		return uuidPattern.MatchString(v)
+	case "uri", "url":
+		u, err := url.Parse(v)
+		return err == nil && u.Scheme != ""
+	}
+	return true
+}
+
+func (s *validator) validateArray(m map[string]interface{}, v []interface{}, path string, vs *[]*Violation, depth int, add func(string, ...interface{})) {
+	if x, ok := integer(m["minItems"]); ok && len(v) < x {
+		add("should have >= %d items", x)
+	}
+	if x, ok := integer(m["maxItems"]); ok && len(v) > x {
+		add("should have <= %d items", x)
+	}
+	if m["uniqueItems"] == true {
+	loop:
+		for i := range v {
+			for j := 0; j < i; j++ {
+				if reflect.DeepEqual(v[i], v[j]) {
+					add("items should be unique, %d and %d are the same", j, i)
+					break loop
+				}
+			}
+		}
+	}
+	if x, ok := m["items"]; ok {
+		for i, e := range v {
+			s.validate(x, e, fmt.Sprintf("%s/%d", path, i), vs, depth+1)
+		}
+	}
+}
+
+func (s *validator) validateObject(m map[string]interface{}, v map[string]interface{}, path string, vs *[]*Violation, depth int, add func(string, ...interface{})) {
+	if x, ok := integer(m["minProperties"]); ok && len(v) < x {
+		add("should have >= %d properties", x)
+	}
+	if x, ok := integer(m["maxProperties"]); ok && len(v) > x {
+		add("should have <= %d properties", x)
+	}
+	if xs, ok := m["required"].([]interface{}); ok {
+		for _, x := range xs {
+			if k, ok := x.(string); ok {
+				if _, ok := v[k]; !ok {
+					add("%s is required", k)
+				}
+			}
+		}
+	}
+	props, _ := m["properties"].(map[string]interface{})
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		p := path + "/" + escape(k)
+		if x, ok := props[k]; ok {
+			s.validate(x, v[k], p, vs, depth+1)
+			continue
+		}
+		if x, ok := m["additionalProperties"]; ok {
+			if x == false {
+				add("%s is not allowed", k)
+				continue
+			}
+			s.validate(x, v[k], p, vs, depth+1)
+		}
+	}
+}
+
+func jsonString(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
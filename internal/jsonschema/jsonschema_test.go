@@ -0,0 +1,255 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/internal/jsonschema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []*struct {
+		title  string
+		schema string
+		isErr  bool
+	}{
+		{
+			title:  "json",
+			schema: `{"type":"object"}`,
+		},
+		{
+			title:  "yaml",
+			schema: "type: object\nrequired: [id]",
+		},
+		{
+			title:  "invalid pattern",
+			schema: `{"type":"string","pattern":"("}`,
+			isErr:  true,
+		},
+		{
+			title:  "unknown ref",
+			schema: `{"$ref":"#/$defs/user"}`,
+			isErr:  true,
+		},
+		{
+			title:  "external ref",
+			schema: `{"$ref":"user.json"}`,
+			isErr:  true,
+		},
+		{
+			title:  "broken",
+			schema: `{"type":`,
+			isErr:  true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			_, err := jsonschema.Parse([]byte(tc.schema))
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	const user = `{
+"$defs":{"name":{"type":"string","minLength":1,"maxLength":8}},
+"type":"object",
+"required":["id","name"],
+"additionalProperties":false,
+"properties":{
+  "id":{"type":"integer","minimum":1},
+  "name":{"$ref":"#/$defs/name"},
+  "email":{"type":"string","format":"email"},
+  "tags":{"type":"array","items":{"type":"string","pattern":"^[a-z]+$"},"uniqueItems":true,"maxItems":2},
+  "role":{"enum":["admin","user"]},
+  "score":{"type":"number","exclusiveMaximum":100,"multipleOf":0.5},
+  "nick":{"type":"string","nullable":true}
+}}`
+	for _, tc := range []*struct {
+		title  string
+		schema string
+		value  string
+		want   []*jsonschema.Violation
+	}{
+		{
+			title:  "valid",
+			schema: user,
+			value:  `{"id":1,"name":"alice","email":"a@example.com","tags":["a","b"],"role":"admin","score":99.5,"nick":null}`,
+		},
+		{
+			title:  "invalid type",
+			schema: user,
+			value:  `[]`,
+			want: []*jsonschema.Violation{
+				{Path: "/", Message: "type should be object, got array"},
+			},
+		},
+		{
+			title:  "required",
+			schema: user,
+			value:  `{"id":1}`,
+			want: []*jsonschema.Violation{
+				{Path: "/", Message: "name is required"},
+			},
+		},
+		{
+			title:  "additional property",
+			schema: user,
+			value:  `{"id":1,"name":"a","age":3}`,
+			want: []*jsonschema.Violation{
+				{Path: "/", Message: "age is not allowed"},
+			},
+		},
+		{
+			title:  "properties",
+			schema: user,
+			value:  `{"id":0.5,"name":"","email":"a","tags":["a","a","B"],"role":"guest","score":100,"nick":1}`,
+			want: []*jsonschema.Violation{
+				{Path: "/email", Message: "should be email format"},
+				{Path: "/id", Message: "type should be integer, got number"},
+				{Path: "/name", Message: "length should be >= 1"},
+				{Path: "/nick", Message: "type should be string, got integer"},
+				{Path: "/role", Message: `should be one of ["admin","user"]`},
+				{Path: "/score", Message: "should be < 100"},
+				{Path: "/tags", Message: "should have <= 2 items"},
+				{Path: "/tags", Message: "items should be unique, 0 and 1 are the same"},
+				{Path: "/tags/2", Message: "should match ^[a-z]+$"},
+			},
+		},
+		{
+			title:  "multipleOf",
+			schema: user,
+			value:  `{"id":1,"name":"a","score":1.2}`,
+			want: []*jsonschema.Violation{
+				{Path: "/score", Message: "should be a multiple of 0.5"},
+			},
+		},
+		{
+			title:  "boolean exclusiveMinimum",
+			schema: `{"type":"number","minimum":0,"exclusiveMinimum":true}`,
+			value:  `0`,
+			want: []*jsonschema.Violation{
+				{Path: "/", Message: "should be > 0"},
+			},
+		},
+		{
+			title:  "type list",
+			schema: `{"type":["string","null"]}`,
+			value:  `null`,
+		},
+		{
+			title:  "anyOf",
+			schema: `{"anyOf":[{"type":"string"},{"type":"integer"}]}`,
+			value:  `true`,
+			want: []*jsonschema.Violation{
+				{Path: "/", Message: "should match any of anyOf"},
+			},
+		},
+		{
+			title:  "oneOf",
+			schema: `{"oneOf":[{"type":"number"},{"type":"integer"}]}`,
+			value:  `1`,
+			want: []*jsonschema.Violation{
+				{Path: "/", Message: "should match exactly one of oneOf, matched 2"},
+			},
+		},
+		{
+			title:  "allOf and not",
+			schema: `{"allOf":[{"minLength":2}],"not":{"const":"ab"}}`,
+			value:  `"ab"`,
+			want: []*jsonschema.Violation{
+				{Path: "/", Message: "should not match not"},
+			},
+		},
+		{
+			title:  "false schema",
+			schema: `{"properties":{"a":false}}`,
+			value:  `{"a":1}`,
+			want: []*jsonschema.Violation{
+				{Path: "/a", Message: "not allowed"},
+			},
+		},
+		{
+			title:  "escaped path",
+			schema: `{"additionalProperties":{"type":"string"}}`,
+			value:  `{"a/b":1}`,
+			want: []*jsonschema.Violation{
+				{Path: "/a~1b", Message: "type should be string, got integer"},
+			},
+		},
+		{
+			title:  "recursive ref",
+			schema: `{"$defs":{"node":{"type":"object","properties":{"next":{"$ref":"#/$defs/node"},"v":{"type":"integer"}}}},"$ref":"#/$defs/node"}`,
+			value:  `{"next":{"next":{"v":"x"}}}`,
+			want: []*jsonschema.Violation{
+				{Path: "/next/next/v", Message: "type should be integer, got string"},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			s, err := jsonschema.Parse([]byte(tc.schema))
+			assert.Nil(t, err)
+			var v interface{}
+			assert.Nil(t, json.Unmarshal([]byte(tc.value), &v))
+			assert.Equal(t, tc.want, s.Validate(v))
+		})
+	}
+}
+
+func TestNewWithRoot(t *testing.T) {
+	root := map[string]interface{}{
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"id": map[string]interface{}{
+					"type": "integer",
+				},
+			},
+		},
+	}
+	s, err := jsonschema.New(map[string]interface{}{
+		"$ref": "#/components/schemas/id",
+	}, root)
+	assert.Nil(t, err)
+	assert.Equal(t, []*jsonschema.Violation{
+		{Path: "/", Message: "type should be integer, got string"},
+	}, s.Validate("1"))
+}
+
+func TestSchemaObject(t *testing.T) {
+	s, err := jsonschema.Parse([]byte(`{
+"$defs":{"flag":{"type":"boolean"}},
+"properties":{
+  "n":{"type":"integer"},
+  "f":{"$ref":"#/$defs/flag"},
+  "s":{"type":"string"},
+  "x":{"type":["integer","string"]},
+  "bad":{"type":"integer"},
+  "ids":{"type":"array","items":{"type":"integer"}},
+  "tags":{"type":"array"}
+}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"n":       float64(10),
+		"f":       true,
+		"s":       "1",
+		"x":       "a",
+		"bad":     "b",
+		"ids":     []interface{}{float64(1), float64(2)},
+		"tags":    []interface{}{"a"},
+		"unknown": "2",
+	}, s.Object(map[string][]string{
+		"n":       {"10", "11"},
+		"f":       {"true"},
+		"s":       {"1"},
+		"x":       {"a"},
+		"bad":     {"b"},
+		"ids":     {"1", "2"},
+		"tags":    {"a"},
+		"unknown": {"2", "3"},
+		"empty":   {},
+	}))
+}
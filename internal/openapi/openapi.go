@@ -0,0 +1,495 @@
+// Package openapi reads OpenAPI 3 documents.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Document is an OpenAPI 3 document, only the parts to mock are read.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components *Components          `json:"components,omitempty"`
+}
+
+type Components struct {
+	Schemas       map[string]*Schema      `json:"schemas,omitempty"`
+	Responses     map[string]*Response    `json:"responses,omitempty"`
+	Parameters    map[string]*Parameter   `json:"parameters,omitempty"`
+	Examples      map[string]*Example     `json:"examples,omitempty"`
+	RequestBodies map[string]*RequestBody `json:"requestBodies,omitempty"`
+}
+
+type PathItem struct {
+	Get        *Operation   `json:"get,omitempty"`
+	Put        *Operation   `json:"put,omitempty"`
+	Post       *Operation   `json:"post,omitempty"`
+	Delete     *Operation   `json:"delete,omitempty"`
+	Options    *Operation   `json:"options,omitempty"`
+	Head       *Operation   `json:"head,omitempty"`
+	Patch      *Operation   `json:"patch,omitempty"`
+	Parameters []*Parameter `json:"parameters,omitempty"`
+}
+
+// Operations returns the operations by method in upper case.
+func (s *PathItem) Operations() map[string]*Operation {
+	ops := map[string]*Operation{}
+	for method, op := range map[string]*Operation{
+		"GET":     s.Get,
+		"PUT":     s.Put,
+		"POST":    s.Post,
+		"DELETE":  s.Delete,
+		"OPTIONS": s.Options,
+		"HEAD":    s.Head,
+		"PATCH":   s.Patch,
+	} {
+		if op != nil {
+			ops[method] = op
+		}
+	}
+	return ops
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses,omitempty"`
+}
+
+type Parameter struct {
+	Ref      string  `json:"$ref,omitempty"`
+	Name     string  `json:"name,omitempty"`
+	In       string  `json:"in,omitempty"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema,omitempty"`
+}
+
+type RequestBody struct {
+	Ref      string                `json:"$ref,omitempty"`
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content,omitempty"`
+}
+
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Headers     map[string]*Header    `json:"headers,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type Header struct {
+	Schema  *Schema     `json:"schema,omitempty"`
+	Example interface{} `json:"example,omitempty"`
+}
+
+type MediaType struct {
+	Schema   *Schema             `json:"schema,omitempty"`
+	Example  interface{}         `json:"example,omitempty"`
+	Examples map[string]*Example `json:"examples,omitempty"`
+}
+
+type Example struct {
+	Ref   string      `json:"$ref,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 Types              `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Example              interface{}        `json:"example,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     interface{}        `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     interface{}        `json:"exclusiveMaximum,omitempty"`
+	MultipleOf           *float64           `json:"multipleOf,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Const                interface{}        `json:"const,omitempty"`
+}
+
+// Types is the type of a schema, a string or an array of strings since OpenAPI 3.1.
+type Types []string
+
+func (t *Types) UnmarshalJSON(b []byte) error {
+	var x string
+	if err := json.Unmarshal(b, &x); err == nil {
+		*t = Types{x}
+		return nil
+	}
+	var xs []string
+	if err := json.Unmarshal(b, &xs); err != nil {
+		return errors.Wrapf(err, errors.InvalidValue, "schema type %s", b)
+	}
+	*t = xs
+	return nil
+}
+
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// Primary returns the first type except null, null if only null, empty if no types.
+func (t Types) Primary() string {
+	for _, x := range t {
+		if x != "null" {
+			return x
+		}
+	}
+	if len(t) > 0 {
+		return "null"
+	}
+	return ""
+}
+
+// Parse parses an OpenAPI 3 document in json or yaml.
+func Parse(b []byte) (*Document, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, errors.Wrap(err, errors.InvalidValue, "parse openapi")
+	}
+	j, err := json.Marshal(normalize(v))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InvalidValue, "parse openapi")
+	}
+	var d Document
+	if err := json.Unmarshal(j, &d); err != nil {
+		return nil, errors.Wrap(err, errors.InvalidValue, "parse openapi")
+	}
+	if !strings.HasPrefix(d.OpenAPI, "3.") {
+		return nil, errors.Newf(errors.InvalidValue, "unsupported openapi version %q", d.OpenAPI)
+	}
+	return &d, nil
+}
+
+// normalize converts the maps of yaml into the maps of json, e.g. the keys of responses may be integers.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, x := range v {
+			v[k] = normalize(x)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, x := range v {
+			m[fmt.Sprint(k)] = normalize(x)
+		}
+		return m
+	case []interface{}:
+		for i, x := range v {
+			v[i] = normalize(x)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// SortedPaths returns the paths in order.
+func (s *Document) SortedPaths() []string {
+	xs := make([]string, 0, len(s.Paths))
+	for p := range s.Paths {
+		xs = append(xs, p)
+	}
+	sort.Strings(xs)
+	return xs
+}
+
+const refPrefix = "#/components/"
+
+func (s *Document) refName(ref, kind string) (string, error) {
+	p := refPrefix + kind + "/"
+	if !strings.HasPrefix(ref, p) {
+		return "", errors.Newf(errors.InvalidValue, "unsupported ref %s", ref)
+	}
+	return strings.TrimPrefix(ref, p), nil
+}
+
+// Schema resolves $ref of the schema.
+func (s *Document) Schema(x *Schema) (*Schema, error) {
+	for i := 0; x != nil && x.Ref != ""; i++ {
+		if i > 32 {
+			return nil, errors.Newf(errors.InvalidValue, "too deep ref %s", x.Ref)
+		}
+		name, err := s.refName(x.Ref, "schemas")
+		if err != nil {
+			return nil, err
+		}
+		y, ok := s.components().Schemas[name]
+		if !ok {
+			return nil, errors.Newf(errors.NotFound, "ref %s", x.Ref)
+		}
+		x = y
+	}
+	return x, nil
+}
+
+// Response resolves $ref of the response.
+func (s *Document) Response(x *Response) (*Response, error) {
+	if x == nil || x.Ref == "" {
+		return x, nil
+	}
+	name, err := s.refName(x.Ref, "responses")
+	if err != nil {
+		return nil, err
+	}
+	y, ok := s.components().Responses[name]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "ref %s", x.Ref)
+	}
+	return y, nil
+}
+
+// Parameter resolves $ref of the parameter.
+func (s *Document) Parameter(x *Parameter) (*Parameter, error) {
+	if x == nil || x.Ref == "" {
+		return x, nil
+	}
+	name, err := s.refName(x.Ref, "parameters")
+	if err != nil {
+		return nil, err
+	}
+	y, ok := s.components().Parameters[name]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "ref %s", x.Ref)
+	}
+	return y, nil
+}
+
+// RequestBody resolves $ref of the request body.
+func (s *Document) RequestBody(x *RequestBody) (*RequestBody, error) {
+	if x == nil || x.Ref == "" {
+		return x, nil
+	}
+	name, err := s.refName(x.Ref, "requestBodies")
+	if err != nil {
+		return nil, err
+	}
+	y, ok := s.components().RequestBodies[name]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "ref %s", x.Ref)
+	}
+	return y, nil
+}
+
+// Example resolves $ref of the example.
+func (s *Document) Example(x *Example) (*Example, error) {
+	if x == nil || x.Ref == "" {
+		return x, nil
+	}
+	name, err := s.refName(x.Ref, "examples")
+	if err != nil {
+		return nil, err
+	}
+	y, ok := s.components().Examples[name]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "ref %s", x.Ref)
+	}
+	return y, nil
+}
+
+func (s *Document) components() *Components {
+	if s.Components == nil {
+		return &Components{}
+	}
+	return s.Components
+}
+
+// MediaExample returns the example of the media type,
+// the first example by name of examples or generated from the schema if no example.
+func (s *Document) MediaExample(m *MediaType) (interface{}, error) {
+	if m.Example != nil {
+		return m.Example, nil
+	}
+	if len(m.Examples) > 0 {
+		names := make([]string, 0, len(m.Examples))
+		for k := range m.Examples {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		x, err := s.Example(m.Examples[names[0]])
+		if err != nil {
+			return nil, err
+		}
+		return x.Value, nil
+	}
+	return s.SchemaExample(m.Schema)
+}
+
+// SchemaExample generates an example value of the schema.
+func (s *Document) SchemaExample(x *Schema) (interface{}, error) {
+	return s.schemaExample(x, map[string]bool{})
+}
+
+func (s *Document) schemaExample(x *Schema, visiting map[string]bool) (interface{}, error) {
+	if x == nil {
+		return nil, nil
+	}
+	if ref := x.Ref; ref != "" {
+		// stop recursive schemas
+		if visiting[ref] {
+			return nil, nil
+		}
+		visiting[ref] = true
+		defer delete(visiting, ref)
+		y, err := s.Schema(x)
+		if err != nil {
+			return nil, err
+		}
+		return s.schemaExample(y, visiting)
+	}
+	switch {
+	case x.Example != nil:
+		return x.Example, nil
+	case x.Const != nil:
+		return x.Const, nil
+	case x.Default != nil:
+		return x.Default, nil
+	case len(x.Enum) > 0:
+		return x.Enum[0], nil
+	case len(x.AllOf) > 0:
+		m := map[string]interface{}{}
+		for _, y := range x.AllOf {
+			v, err := s.schemaExample(y, visiting)
+			if err != nil {
+				return nil, err
+			}
+			if vm, ok := v.(map[string]interface{}); ok {
+				for k, e := range vm {
+					m[k] = e
+				}
+			}
+		}
+		return m, nil
+	case len(x.OneOf) > 0:
+		return s.schemaExample(x.OneOf[0], visiting)
+	case len(x.AnyOf) > 0:
+		return s.schemaExample(x.AnyOf[0], visiting)
+	}
+	switch x.Type.Primary() {
+	case "object", "":
+		if x.Type.Primary() == "" && len(x.Properties) == 0 {
+			if x.Items != nil {
+				return s.arrayExample(x, visiting)
+			}
+			return nil, nil
+		}
+		m := map[string]interface{}{}
+		for k, y := range x.Properties {
+			v, err := s.schemaExample(y, visiting)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	case "array":
+		return s.arrayExample(x, visiting)
+	case "string":
+		return stringExample(x), nil
+	case "integer":
+		return numberExample(x, true), nil
+	case "number":
+		return numberExample(x, false), nil
+	case "boolean":
+		return true, nil
+	case "null":
+		return nil, nil
+	}
+	return nil, errors.Newf(errors.InvalidValue, "unsupported schema type %s", x.Type.Primary())
+}
+
+func (s *Document) arrayExample(x *Schema, visiting map[string]bool) (interface{}, error) {
+	if x.Items == nil {
+		return []interface{}{}, nil
+	}
+	v, err := s.schemaExample(x.Items, visiting)
+	if err != nil {
+		return nil, err
+	}
+	n := 1
+	if x.MinItems != nil && *x.MinItems > n {
+		n = *x.MinItems
+	}
+	xs := make([]interface{}, n)
+	for i := range xs {
+		xs[i] = v
+	}
+	return xs, nil
+}
+
+func stringExample(x *Schema) string {
+	switch x.Format {
+	case "date-time":
+		return "1970-01-01T00:00:00Z"
+	case "date":
+		return "1970-01-01"
+	case "time":
+		return "00:00:00"
+	case "email":
+		return "user@example.com"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000000"
+	case "uri", "url":
+		return "https://example.com"
+	case "hostname":
+		return "example.com"
+	case "ipv4":
+		return "127.0.0.1"
+	case "ipv6":
+		return "::1"
+	case "byte":
+		return "c3RyaW5n"
+	}
+	v := "string"
+	if x.MinLength != nil {
+		for len(v) < *x.MinLength {
+			v += "x"
+		}
+	}
+	if x.MaxLength != nil && len(v) > *x.MaxLength {
+		v = v[:*x.MaxLength]
+	}
+	return v
+}
+
+func numberExample(x *Schema, isInt bool) float64 {
+	var v float64
+	if x.Minimum != nil {
+		v = *x.Minimum
+	} else if x.Maximum != nil && *x.Maximum < 0 {
+		v = *x.Maximum
+	}
+	if isInt && float64(int64(v)) != v {
+		v = float64(int64(v) + 1)
+	}
+	return v
+}
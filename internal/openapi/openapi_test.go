@@ -0,0 +1,231 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/internal/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+const document = `
+openapi: 3.0.3
+info:
+  title: users
+  version: 1.0.0
+paths:
+  /users/{id}:
+    parameters:
+      - $ref: '#/components/parameters/id'
+    get:
+      operationId: getUser
+      responses:
+        200:
+          description: user
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/User'
+        '404':
+          $ref: '#/components/responses/NotFound'
+    delete:
+      responses:
+        '204':
+          description: deleted
+components:
+  parameters:
+    id:
+      name: id
+      in: path
+      required: true
+      schema:
+        type: integer
+  responses:
+    NotFound:
+      description: not found
+      content:
+        application/json:
+          examples:
+            b:
+              value: {message: b}
+            a:
+              $ref: '#/components/examples/NotFound'
+  examples:
+    NotFound:
+      value: {message: not found}
+  schemas:
+    User:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+          minimum: 1
+        name:
+          type: string
+          minLength: 8
+        email:
+          type: string
+          format: email
+        role:
+          type: string
+          enum: [admin, guest]
+        tags:
+          type: array
+          minItems: 2
+          items:
+            type: string
+            example: tag
+        active:
+          type: boolean
+          default: false
+        profile:
+          allOf:
+            - $ref: '#/components/schemas/Profile'
+            - type: object
+              properties:
+                age:
+                  type: number
+        friend:
+          $ref: '#/components/schemas/User'
+    Profile:
+      type: object
+      properties:
+        bio:
+          type: string
+          example: hello
+`
+
+func TestParse(t *testing.T) {
+	for _, tc := range []*struct {
+		title string
+		doc   string
+		isErr bool
+	}{
+		{
+			title: "yaml",
+			doc:   document,
+		},
+		{
+			title: "json",
+			doc:   `{"openapi":"3.1.0","paths":{"/hello":{"get":{"responses":{"200":{"description":"ok"}}}}}}`,
+		},
+		{
+			title: "swagger",
+			doc:   `{"swagger":"2.0","paths":{}}`,
+			isErr: true,
+		},
+		{
+			title: "invalid",
+			doc:   `{`,
+			isErr: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			_, err := openapi.Parse([]byte(tc.doc))
+			assert.Equal(t, tc.isErr, err != nil, "%v", err)
+		})
+	}
+}
+
+func TestDocument(t *testing.T) {
+	d, err := openapi.Parse([]byte(document))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/users/{id}"}, d.SortedPaths())
+	item := d.Paths["/users/{id}"]
+	ops := item.Operations()
+	assert.Equal(t, 2, len(ops))
+	op := ops["GET"]
+	assert.Equal(t, "getUser", op.OperationID)
+
+	t.Run("parameter", func(t *testing.T) {
+		p, err := d.Parameter(item.Parameters[0])
+		assert.Nil(t, err)
+		assert.Equal(t, "id", p.Name)
+		assert.Equal(t, "path", p.In)
+	})
+
+	t.Run("generate example", func(t *testing.T) {
+		got, err := d.MediaExample(op.Responses["200"].Content["application/json"])
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"id":     1.0,
+			"name":   "stringxx",
+			"email":  "user@example.com",
+			"role":   "admin",
+			"tags":   []interface{}{"tag", "tag"},
+			"active": false,
+			"profile": map[string]interface{}{
+				"bio": "hello",
+				"age": 0.0,
+			},
+			"friend": nil,
+		}, got)
+	})
+
+	t.Run("example by ref", func(t *testing.T) {
+		res, err := d.Response(op.Responses["404"])
+		assert.Nil(t, err)
+		got, err := d.MediaExample(res.Content["application/json"])
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"message": "not found",
+		}, got)
+	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+		_, err := d.Schema(&openapi.Schema{Ref: "#/components/schemas/Unknown"})
+		assert.NotNil(t, err)
+		_, err = d.Schema(&openapi.Schema{Ref: "other.yaml#/User"})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestTypes(t *testing.T) {
+	for _, tc := range []*struct {
+		title   string
+		schema  string
+		want    openapi.Types
+		primary string
+		example interface{}
+	}{
+		{
+			title:   "string",
+			schema:  `{"type":"string","example":"a"}`,
+			want:    openapi.Types{"string"},
+			primary: "string",
+			example: "a",
+		},
+		{
+			title:   "nullable in 3.1",
+			schema:  `{"type":["null","integer"],"minimum":3}`,
+			want:    openapi.Types{"null", "integer"},
+			primary: "integer",
+			example: 3.0,
+		},
+		{
+			title:   "null",
+			schema:  `{"type":"null"}`,
+			want:    openapi.Types{"null"},
+			primary: "null",
+		},
+		{
+			title:  "no type",
+			schema: `{}`,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			d, err := openapi.Parse([]byte(`{"openapi":"3.1.0","paths":{},"components":{"schemas":{"x":` + tc.schema + `}}}`))
+			assert.Nil(t, err)
+			x := d.Components.Schemas["x"]
+			assert.Equal(t, tc.want, x.Type)
+			assert.Equal(t, tc.primary, x.Type.Primary())
+			got, err := d.SchemaExample(x)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.example, got)
+			b, err := json.Marshal(x)
+			assert.Nil(t, err)
+			assert.JSONEq(t, tc.schema, string(b))
+		})
+	}
+}
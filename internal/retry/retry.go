@@ -0,0 +1,69 @@
+// Package retry provides backoff between attempts.
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Backoff calculates the wait before the next attempt.
+type Backoff interface {
+	// Duration returns the wait after the attempt failed, attempt starts from 1.
+	Duration(attempt int) time.Duration
+}
+
+// NewBackoff returns an exponential backoff.
+// The wait doubles from base per attempt up to max, no limit if max is 0.
+// jitter is the ratio in [0, 1] of the wait reduced randomly by rnd, which returns a number in [0, 1).
+// rnd is math/rand.Float64 if nil.
+func NewBackoff(base, max time.Duration, jitter float64, rnd func() float64) Backoff {
+	if rnd == nil {
+		rnd = rand.Float64
+	}
+	return &backoff{
+		base:   base,
+		max:    max,
+		jitter: jitter,
+		rnd:    rnd,
+	}
+}
+
+type backoff struct {
+	base   time.Duration
+	max    time.Duration
+	jitter float64
+	rnd    func() float64
+}
+
+// maxDuration prevents doubling from overflow.
+const maxDuration = time.Duration(1 << 62)
+
+func (s *backoff) Duration(attempt int) time.Duration {
+	d := s.base
+	for i := 1; i < attempt && d < maxDuration/2; i++ {
+		d *= 2
+		if s.max > 0 && d >= s.max {
+			break
+		}
+	}
+	if s.max > 0 && d > s.max {
+		d = s.max
+	}
+	if s.jitter > 0 {
+		d -= time.Duration(float64(d) * s.jitter * s.rnd())
+	}
+	return d
+}
+
+// Sleep waits d or until ctx is done.
+func Sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
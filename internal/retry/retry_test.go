@@ -0,0 +1,75 @@
+package retry_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	for _, tc := range []*struct {
+		title   string
+		base    time.Duration
+		max     time.Duration
+		jitter  float64
+		rnd     float64
+		attempt int
+		want    time.Duration
+	}{
+		{
+			title:   "first",
+			base:    100 * time.Millisecond,
+			attempt: 1,
+			want:    100 * time.Millisecond,
+		},
+		{
+			title:   "exponential",
+			base:    100 * time.Millisecond,
+			attempt: 4,
+			want:    800 * time.Millisecond,
+		},
+		{
+			title:   "max",
+			base:    100 * time.Millisecond,
+			max:     300 * time.Millisecond,
+			attempt: 4,
+			want:    300 * time.Millisecond,
+		},
+		{
+			title:   "jitter",
+			base:    100 * time.Millisecond,
+			jitter:  0.5,
+			rnd:     0.5,
+			attempt: 2,
+			want:    150 * time.Millisecond,
+		},
+		{
+			title:   "zero",
+			attempt: 3,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			b := retry.NewBackoff(tc.base, tc.max, tc.jitter, func() float64 { return tc.rnd })
+			assert.Equal(t, tc.want, b.Duration(tc.attempt))
+		})
+	}
+}
+
+func TestBackoffOverflow(t *testing.T) {
+	b := retry.NewBackoff(time.Second, 0, 0, nil)
+	assert.True(t, b.Duration(1000) > b.Duration(10))
+}
+
+func TestSleep(t *testing.T) {
+	t.Run("elapsed", func(t *testing.T) {
+		assert.Nil(t, retry.Sleep(context.Background(), time.Millisecond))
+	})
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.Equal(t, context.Canceled, retry.Sleep(ctx, time.Hour))
+	})
+}
@@ -2,6 +2,8 @@ package util
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	"github.com/berquerant/jsonhttp/internal/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -11,6 +13,9 @@ import (
 // IsInt returns true if v is an integer.
 func IsInt(v float64) bool { return float64(int(v)) == v }
 
+// IsHTTPStatus returns true if v is a valid http status code.
+func IsHTTPStatus(v int) bool { return v >= 100 && v <= 599 }
+
 // MergeStringMap merges string maps.
 // Overwrites dest by values from other for the same keys.
 // Panic if dest is nil.
@@ -20,6 +25,15 @@ func MergeStringMap(dest, other map[string]string) {
 	}
 }
 
+// MergeStringsMap merges string slice maps.
+// Overwrites dest by values from other for the same keys.
+// Panic if dest is nil.
+func MergeStringsMap(dest, other map[string][]string) {
+	for k, v := range other {
+		dest[k] = v
+	}
+}
+
 // MergeMap merges interface maps.
 // Overwrites dest by values from other for the same keys.
 // Panic if dest is nil.
@@ -59,3 +73,147 @@ func JSON(v interface{}) string {
 	}
 	return string(b)
 }
+
+// GetPath returns the value at keys in v.
+// keys are keys of maps or indexes of lists, negative index counts from the end.
+func GetPath(v interface{}, keys []string) (interface{}, bool) {
+	for _, k := range keys {
+		switch x := v.(type) {
+		case map[string]interface{}:
+			e, ok := x[k]
+			if !ok {
+				return nil, false
+			}
+			v = e
+		case []interface{}:
+			i, ok := listIndex(k, len(x))
+			if !ok || i >= len(x) {
+				return nil, false
+			}
+			v = x[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// SetPath sets value at keys in dest.
+// Creates maps for missing keys, index equal to the length of list means append.
+func SetPath(dest map[string]interface{}, keys []string, value interface{}) error {
+	if len(keys) == 0 {
+		return errors.New(errors.InvalidArgument, "no keys")
+	}
+	_, err := setPath(dest, keys, value)
+	return err
+}
+
+func setPath(v interface{}, keys []string, value interface{}) (interface{}, error) {
+	if len(keys) == 0 {
+		return value, nil
+	}
+	k := keys[0]
+	switch x := v.(type) {
+	case nil:
+		m := map[string]interface{}{}
+		e, err := setPath(nil, keys[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = e
+		return m, nil
+	case map[string]interface{}:
+		e, err := setPath(x[k], keys[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		x[k] = e
+		return x, nil
+	case []interface{}:
+		i, ok := listIndex(k, len(x))
+		if !ok || i > len(x) {
+			return nil, errors.Newf(errors.OutOfRange, "index %s of list", k)
+		}
+		if i == len(x) {
+			x = append(x, nil)
+		}
+		e, err := setPath(x[i], keys[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		x[i] = e
+		return x, nil
+	}
+	return nil, errors.Newf(errors.InvalidArgument, "cannot set %s into %T", k, v)
+}
+
+// DeletePath deletes the value at keys in dest.
+// Does nothing if not found.
+func DeletePath(dest map[string]interface{}, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	parent, ok := GetPath(dest, keys[:len(keys)-1])
+	if !ok {
+		return
+	}
+	last := keys[len(keys)-1]
+	switch x := parent.(type) {
+	case map[string]interface{}:
+		delete(x, last)
+	case []interface{}:
+		i, ok := listIndex(last, len(x))
+		if !ok || i >= len(x) {
+			return
+		}
+		// replace the list in the parent of the list
+		_ = SetPath(dest, keys[:len(keys)-1], append(x[:i:i], x[i+1:]...))
+	}
+}
+
+func listIndex(k string, length int) (int, bool) {
+	i, err := strconv.Atoi(k)
+	if err != nil {
+		return 0, false
+	}
+	if i < 0 {
+		i += length
+	}
+	return i, i >= 0
+}
+
+// PathPatternPrefix returns the pattern of http.ServeMux covering the path template, e.g. /users/ for /users/{id},
+// false if the pattern has no path parameters.
+func PathPatternPrefix(pattern string) (string, bool) {
+	i := strings.Index(pattern, "{")
+	if i < 0 {
+		return pattern, false
+	}
+	return pattern[:strings.LastIndex(pattern[:i], "/")+1], true
+}
+
+// MatchPathPattern matches the path against the path template, e.g. /users/{id},
+// and returns the path parameters by name.
+func MatchPathPattern(pattern, path string) (map[string]string, bool) {
+	var (
+		ps     = strings.Split(strings.Trim(pattern, "/"), "/")
+		xs     = strings.Split(strings.Trim(path, "/"), "/")
+		params = map[string]string{}
+	)
+	if len(ps) != len(xs) {
+		return nil, false
+	}
+	for i, p := range ps {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			if xs[i] == "" {
+				return nil, false
+			}
+			params[p[1:len(p)-1]] = xs[i]
+			continue
+		}
+		if p != xs[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
@@ -17,6 +17,21 @@ func TestIsInt(t *testing.T) {
 	})
 }
 
+func TestIsHTTPStatus(t *testing.T) {
+	for _, tc := range []*struct {
+		v    int
+		want bool
+	}{
+		{v: 0},
+		{v: 99},
+		{v: 100, want: true},
+		{v: 599, want: true},
+		{v: 600},
+	} {
+		assert.Equal(t, tc.want, util.IsHTTPStatus(tc.v), tc.v)
+	}
+}
+
 func TestMergeMap(t *testing.T) {
 	for _, tc := range []*struct {
 		title             string
@@ -193,3 +208,314 @@ func TestMergeStringMap(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeStringsMap(t *testing.T) {
+	for _, tc := range []*struct {
+		title             string
+		dest, other, want map[string][]string
+	}{
+		{
+			title: "zero",
+			want:  map[string][]string{},
+		},
+		{
+			title: "independent",
+			dest: map[string][]string{
+				"k2": {"v2"},
+			},
+			other: map[string][]string{
+				"k1": {"v1", "v11"},
+			},
+			want: map[string][]string{
+				"k1": {"v1", "v11"},
+				"k2": {"v2"},
+			},
+		},
+		{
+			title: "dependent",
+			dest: map[string][]string{
+				"k1": {"v1", "v11"},
+			},
+			other: map[string][]string{
+				"k1": {"x"},
+			},
+			want: map[string][]string{
+				"k1": {"x"},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got := make(map[string][]string, len(tc.dest))
+			for k, v := range tc.dest {
+				got[k] = v
+			}
+			util.MergeStringsMap(got, tc.other)
+			assert.Equal(t, "", cmp.Diff(got, tc.want))
+		})
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	v := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": []interface{}{1, map[string]interface{}{"c": "x"}},
+		},
+	}
+	for _, tc := range []*struct {
+		title string
+		keys  []string
+		want  interface{}
+		ok    bool
+	}{
+		{
+			title: "root",
+			want:  v,
+			ok:    true,
+		},
+		{
+			title: "deep",
+			keys:  []string{"a", "b", "1", "c"},
+			want:  "x",
+			ok:    true,
+		},
+		{
+			title: "negative index",
+			keys:  []string{"a", "b", "-2"},
+			want:  1,
+			ok:    true,
+		},
+		{
+			title: "missing key",
+			keys:  []string{"a", "z"},
+		},
+		{
+			title: "out of range",
+			keys:  []string{"a", "b", "2"},
+		},
+		{
+			title: "not index",
+			keys:  []string{"a", "b", "x"},
+		},
+		{
+			title: "scalar",
+			keys:  []string{"a", "b", "0", "c"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got, ok := util.GetPath(v, tc.keys)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, "", cmp.Diff(tc.want, got))
+		})
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	newDest := func() map[string]interface{} {
+		return map[string]interface{}{
+			"a": map[string]interface{}{
+				"b": []interface{}{1, map[string]interface{}{"c": "x"}},
+			},
+			"s": "str",
+		}
+	}
+	for _, tc := range []*struct {
+		title string
+		keys  []string
+		want  map[string]interface{}
+		isErr bool
+	}{
+		{
+			title: "no keys",
+			isErr: true,
+		},
+		{
+			title: "top",
+			keys:  []string{"s"},
+			want: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{1, map[string]interface{}{"c": "x"}},
+				},
+				"s": "v",
+			},
+		},
+		{
+			title: "create maps",
+			keys:  []string{"n", "m"},
+			want: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{1, map[string]interface{}{"c": "x"}},
+				},
+				"s": "str",
+				"n": map[string]interface{}{"m": "v"},
+			},
+		},
+		{
+			title: "list element",
+			keys:  []string{"a", "b", "1", "c"},
+			want: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{1, map[string]interface{}{"c": "v"}},
+				},
+				"s": "str",
+			},
+		},
+		{
+			title: "append",
+			keys:  []string{"a", "b", "2"},
+			want: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{1, map[string]interface{}{"c": "x"}, "v"},
+				},
+				"s": "str",
+			},
+		},
+		{
+			title: "out of range",
+			keys:  []string{"a", "b", "3"},
+			isErr: true,
+		},
+		{
+			title: "into scalar",
+			keys:  []string{"s", "x"},
+			isErr: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got := newDest()
+			err := util.SetPath(got, tc.keys, "v")
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "", cmp.Diff(tc.want, got))
+		})
+	}
+}
+
+func TestDeletePath(t *testing.T) {
+	newDest := func() map[string]interface{} {
+		return map[string]interface{}{
+			"a": map[string]interface{}{
+				"b": []interface{}{1, 2, 3},
+				"c": "x",
+			},
+		}
+	}
+	for _, tc := range []*struct {
+		title string
+		keys  []string
+		want  map[string]interface{}
+	}{
+		{
+			title: "no keys",
+			want:  newDest(),
+		},
+		{
+			title: "missing",
+			keys:  []string{"a", "z", "y"},
+			want:  newDest(),
+		},
+		{
+			title: "map key",
+			keys:  []string{"a", "c"},
+			want: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{1, 2, 3},
+				},
+			},
+		},
+		{
+			title: "list element",
+			keys:  []string{"a", "b", "1"},
+			want: map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{1, 3},
+					"c": "x",
+				},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got := newDest()
+			util.DeletePath(got, tc.keys)
+			assert.Equal(t, "", cmp.Diff(tc.want, got))
+		})
+	}
+}
+
+func TestPathPatternPrefix(t *testing.T) {
+	for _, tc := range []*struct {
+		pattern string
+		want    string
+		ok      bool
+	}{
+		{pattern: "/users", want: "/users"},
+		{pattern: "/users/", want: "/users/"},
+		{pattern: "/users/{id}", want: "/users/", ok: true},
+		{pattern: "/users/{id}/posts/{postId}", want: "/users/", ok: true},
+		{pattern: "/{id}", want: "/", ok: true},
+	} {
+		got, ok := util.PathPatternPrefix(tc.pattern)
+		assert.Equal(t, tc.ok, ok, tc.pattern)
+		assert.Equal(t, tc.want, got, tc.pattern)
+	}
+}
+
+func TestMatchPathPattern(t *testing.T) {
+	for _, tc := range []*struct {
+		title   string
+		pattern string
+		path    string
+		want    map[string]string
+		ok      bool
+	}{
+		{
+			title:   "no params",
+			pattern: "/users",
+			path:    "/users",
+			want:    map[string]string{},
+			ok:      true,
+		},
+		{
+			title:   "params",
+			pattern: "/users/{id}/posts/{postId}",
+			path:    "/users/1/posts/10",
+			want: map[string]string{
+				"id":     "1",
+				"postId": "10",
+			},
+			ok: true,
+		},
+		{
+			title:   "trailing slash",
+			pattern: "/users/{id}",
+			path:    "/users/1/",
+			want: map[string]string{
+				"id": "1",
+			},
+			ok: true,
+		},
+		{
+			title:   "unmatched segment",
+			pattern: "/users/{id}/posts",
+			path:    "/users/1/comments",
+		},
+		{
+			title:   "too long",
+			pattern: "/users/{id}",
+			path:    "/users/1/posts",
+		},
+		{
+			title:   "empty param",
+			pattern: "/users/{id}/posts",
+			path:    "/users//posts",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got, ok := util.MatchPathPattern(tc.pattern, tc.path)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
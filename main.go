@@ -3,21 +3,25 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"io"
 	"os"
 	"os/signal"
 	"time"
 
 	"github.com/berquerant/jsonhttp/internal/logger"
+	"github.com/berquerant/jsonhttp/internal/openapi"
 	"github.com/berquerant/jsonhttp/pb"
 	"github.com/berquerant/jsonhttp/server"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var (
-	config  = flag.String("c", "server.json", "config file")
-	port    = flag.Int("p", 0, "port number")
-	isDebug = flag.Bool("debug", false, "enable debug log")
+	config      = flag.String("c", "server.json", "config file")
+	port        = flag.Int("p", 0, "port number")
+	isDebug     = flag.Bool("debug", false, "enable debug log")
+	openAPIFile = flag.String("openapi", "", "OpenAPI 3 document (json or yaml) to mock instead of config file")
+	dump        = flag.Bool("dump", false, "print config and exit")
 )
 
 func panicOnError(err error) {
@@ -29,16 +33,26 @@ func panicOnError(err error) {
 func main() {
 	flag.Parse()
 	logger.IsDebug = *isDebug
-	f, err := os.Open(*config)
-	panicOnError(err)
-	defer f.Close()
-	b, err := io.ReadAll(f)
 	var value pb.Server
-	panicOnError(protojson.Unmarshal(b, &value))
+	if *openAPIFile != "" {
+		panicOnError(readOpenAPI(*openAPIFile, &value))
+	} else {
+		panicOnError(readConfig(*config, &value))
+	}
+	panicOnError(pb.Validate(&value))
 	if *port > 0 {
 		// override port number
 		value.Port = int32(*port)
 	}
+	if *dump {
+		b, err := protojson.MarshalOptions{
+			Multiline: true,
+			Indent:    "  ",
+		}.Marshal(&value)
+		panicOnError(err)
+		fmt.Println(string(b))
+		return
+	}
 
 	s := server.New(&value)
 	go func() {
@@ -49,5 +63,36 @@ func main() {
 		defer cancel()
 		s.Close(ctx)
 	}()
-	s.Start()
+	panicOnError(s.Start())
+}
+
+func readConfig(file string, value *pb.Server) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(b, value)
+}
+
+// readOpenAPI builds the config mocking the operations of the OpenAPI document.
+func readOpenAPI(file string, value *pb.Server) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	doc, err := openapi.Parse(b)
+	if err != nil {
+		return err
+	}
+	handlers, err := pb.NewOpenAPIBuilder(pb.NewValueConverter()).Build(doc)
+	if err != nil {
+		return err
+	}
+	value.Handlers = handlers
+	return nil
 }
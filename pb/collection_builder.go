@@ -0,0 +1,469 @@
+package pb
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+	"google.golang.org/protobuf/proto"
+)
+
+// ItemTemplateSource is a TemplateSource with the element of a collection.
+type ItemTemplateSource interface {
+	TemplateSource
+	Item() *Value
+	// Key returns the index of List or the key of Map.
+	Key() *Value
+}
+
+func NewItemTemplateSource(src TemplateSource, item, key *Value) ItemTemplateSource {
+	return &itemTemplateSource{
+		TemplateSource: src,
+		item:           item,
+		key:            key,
+	}
+}
+
+type itemTemplateSource struct {
+	TemplateSource
+	item *Value
+	key  *Value
+}
+
+func (s *itemTemplateSource) Item() *Value { return s.item }
+func (s *itemTemplateSource) Key() *Value  { return s.key }
+
+// ItemBuilder extracts the element in Collection item.
+type ItemBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewItemBuilder(item *Value_Item) ItemBuilder {
+	return &itemBuilder{
+		item: item,
+	}
+}
+
+type itemBuilder struct {
+	item *Value_Item
+}
+
+func (s *itemBuilder) Build(r TemplateSource) (*Value, error) {
+	src, ok := r.(ItemTemplateSource)
+	if !ok {
+		return nil, errors.New(errors.InvalidSettings, "item is available only in collection item")
+	}
+	switch s.item.GetPart() {
+	case Value_Item_KEY:
+		return src.Key().Clone(), nil
+	case Value_Item_VALUE:
+		v := src.Item()
+		for i, k := range s.item.GetKeys() {
+			x, err := getElement(v, k)
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.NotFound, "item at %d key", i)
+			}
+			v = x
+		}
+		return v.Clone(), nil
+	}
+	return nil, errors.Newf(errors.UnknownError, "item builder %s", s.item.GetPart())
+}
+
+// getElement returns the element of List or Map.
+// key is an index for List.
+func getElement(v *Value, key string) (*Value, error) {
+	switch v.GetValue().(type) {
+	case *Value_L:
+		i, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidArgument, "index of list %s", key)
+		}
+		xs := v.GetL().GetValues()
+		if i < 0 {
+			i += len(xs)
+		}
+		if i < 0 || i >= len(xs) {
+			return nil, errors.Newf(errors.OutOfRange, "at %d in list", i)
+		}
+		return xs[i], nil
+	case *Value_M:
+		x, ok := v.GetM().GetValues()[key]
+		if !ok {
+			return nil, errors.Newf(errors.NotFound, "%s not in map", key)
+		}
+		return x, nil
+	}
+	return nil, errors.Newf(errors.InvalidArgument, "cannot get %s from %s", key, util.JSON(v))
+}
+
+// CollectionBuilder operates on List and Map.
+type CollectionBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewCollectionBuilder(collection *Value_Collection, valueCaster ValueCaster, templateValueBuilder TemplateValueBuilder) CollectionBuilder {
+	return &collectionBuilder{
+		collection:           collection,
+		valueCaster:          valueCaster,
+		templateValueBuilder: templateValueBuilder,
+	}
+}
+
+type collectionBuilder struct {
+	collection           *Value_Collection
+	valueCaster          ValueCaster
+	templateValueBuilder TemplateValueBuilder
+}
+
+func (s *collectionBuilder) Build(r TemplateSource) (*Value, error) {
+	v, err := s.templateValueBuilder.Build(s.collection.GetValue(), r)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build collection %s", s.collection.GetType())
+	}
+	args := make([]*Value, len(s.collection.GetArgs()))
+	for i, a := range s.collection.GetArgs() {
+		x, err := s.templateValueBuilder.Build(a, r)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build collection %s arg %d", s.collection.GetType(), i)
+		}
+		args[i] = x
+	}
+	x, err := s.build(v, args, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build collection %s %s", s.collection.GetType(), util.JSON(v))
+	}
+	return x, nil
+}
+
+func (s *collectionBuilder) build(v *Value, args []*Value, r TemplateSource) (*Value, error) {
+	switch s.collection.GetType() {
+	case Value_Collection_LEN:
+		switch v.GetValue().(type) {
+		case *Value_L:
+			return NewN(float64(len(v.GetL().GetValues()))), nil
+		case *Value_M:
+			return NewN(float64(len(v.GetM().GetValues()))), nil
+		case *Value_S:
+			return NewN(float64(len([]rune(v.GetS())))), nil
+		}
+		return nil, errors.New(errors.InvalidArgument, "no length")
+	case Value_Collection_GET:
+		if len(args) < 1 {
+			return nil, errors.New(errors.InvalidSettings, "get requires a key")
+		}
+		k, err := s.valueCaster.String(args[0])
+		if err != nil {
+			return nil, errors.Wrap(err, errors.TypeCast, "key of get")
+		}
+		x, err := getElement(v, k)
+		if err != nil {
+			return nil, err
+		}
+		return x.Clone(), nil
+	case Value_Collection_SLICE:
+		return s.slice(v, args)
+	case Value_Collection_FILTER:
+		return s.filter(v, r)
+	case Value_Collection_MAP:
+		return s.mapItems(v, r)
+	case Value_Collection_SORT:
+		return s.sort(v, r)
+	case Value_Collection_UNIQUE:
+		xs, err := listValues(v)
+		if err != nil {
+			return nil, err
+		}
+		var p []*Value
+		for _, x := range xs {
+			if !containsValue(p, x) {
+				p = append(p, x.Clone())
+			}
+		}
+		return NewL(p), nil
+	case Value_Collection_MERGE:
+		if v.GetM() == nil {
+			return nil, errors.New(errors.InvalidArgument, "merge requires map")
+		}
+		d := v.Clone()
+		for i, a := range args {
+			if a.GetM() == nil {
+				return nil, errors.Newf(errors.InvalidArgument, "merge arg %d is not map", i)
+			}
+			mergeValueMap(d, a.Clone())
+		}
+		return d, nil
+	case Value_Collection_PICK, Value_Collection_OMIT:
+		if v.GetM() == nil {
+			return nil, errors.New(errors.InvalidArgument, "pick and omit require map")
+		}
+		keys := map[string]bool{}
+		for i, a := range args {
+			k, err := s.valueCaster.String(a)
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.TypeCast, "key %d", i)
+			}
+			keys[k] = true
+		}
+		isPick := s.collection.GetType() == Value_Collection_PICK
+		d := map[string]*Value{}
+		for k, x := range v.GetM().GetValues() {
+			if keys[k] == isPick {
+				d[k] = x.Clone()
+			}
+		}
+		return NewM(d), nil
+	case Value_Collection_KEYS, Value_Collection_VALUES:
+		if v.GetM() == nil {
+			return nil, errors.New(errors.InvalidArgument, "keys and values require map")
+		}
+		keys := sortedKeys(v.GetM().GetValues())
+		p := make([]*Value, len(keys))
+		for i, k := range keys {
+			if s.collection.GetType() == Value_Collection_KEYS {
+				p[i] = NewS(k)
+			} else {
+				p[i] = v.GetM().GetValues()[k].Clone()
+			}
+		}
+		return NewL(p), nil
+	}
+	return nil, errors.New(errors.UnknownError, "collection builder")
+}
+
+func (s *collectionBuilder) slice(v *Value, args []*Value) (*Value, error) {
+	xs, err := listValues(v)
+	if err != nil {
+		return nil, err
+	}
+	index := func(i int, def int) (int, error) {
+		if i >= len(args) {
+			return def, nil
+		}
+		x, err := s.valueCaster.Int(args[i])
+		if err != nil {
+			return 0, errors.Wrapf(err, errors.TypeCast, "slice arg %d", i)
+		}
+		if x < 0 {
+			x += len(xs)
+		}
+		if x < 0 {
+			return 0, nil
+		}
+		if x > len(xs) {
+			return len(xs), nil
+		}
+		return x, nil
+	}
+	start, err := index(0, 0)
+	if err != nil {
+		return nil, err
+	}
+	end, err := index(1, len(xs))
+	if err != nil {
+		return nil, err
+	}
+	p := []*Value{}
+	for i := start; i < end; i++ {
+		p = append(p, xs[i].Clone())
+	}
+	return NewL(p), nil
+}
+
+func (s *collectionBuilder) buildItem(r TemplateSource, item, key *Value) (*Value, error) {
+	if s.collection.GetItem() == nil {
+		return nil, errors.New(errors.InvalidSettings, "item is required")
+	}
+	x, err := s.templateValueBuilder.Build(s.collection.GetItem(), NewItemTemplateSource(r, item, key))
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "item %s", util.JSON(key))
+	}
+	return x, nil
+}
+
+func (s *collectionBuilder) filter(v *Value, r TemplateSource) (*Value, error) {
+	keep := func(item, key *Value) (bool, error) {
+		x, err := s.buildItem(r, item, key)
+		if err != nil {
+			return false, err
+		}
+		return s.valueCaster.Bool(x)
+	}
+	switch v.GetValue().(type) {
+	case *Value_L:
+		p := []*Value{}
+		for i, x := range v.GetL().GetValues() {
+			ok, err := keep(x, NewN(float64(i)))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				p = append(p, x.Clone())
+			}
+		}
+		return NewL(p), nil
+	case *Value_M:
+		d := map[string]*Value{}
+		for k, x := range v.GetM().GetValues() {
+			ok, err := keep(x, NewS(k))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				d[k] = x.Clone()
+			}
+		}
+		return NewM(d), nil
+	}
+	return nil, errors.New(errors.InvalidArgument, "filter requires list or map")
+}
+
+func (s *collectionBuilder) mapItems(v *Value, r TemplateSource) (*Value, error) {
+	switch v.GetValue().(type) {
+	case *Value_L:
+		p := []*Value{}
+		for i, x := range v.GetL().GetValues() {
+			y, err := s.buildItem(r, x, NewN(float64(i)))
+			if err != nil {
+				return nil, err
+			}
+			p = append(p, y)
+		}
+		return NewL(p), nil
+	case *Value_M:
+		d := map[string]*Value{}
+		for k, x := range v.GetM().GetValues() {
+			y, err := s.buildItem(r, x, NewS(k))
+			if err != nil {
+				return nil, err
+			}
+			d[k] = y
+		}
+		return NewM(d), nil
+	}
+	return nil, errors.New(errors.InvalidArgument, "map requires list or map")
+}
+
+func (s *collectionBuilder) sort(v *Value, r TemplateSource) (*Value, error) {
+	xs, err := listValues(v)
+	if err != nil {
+		return nil, err
+	}
+	type pair struct {
+		key   *Value
+		value *Value
+	}
+	ps := make([]*pair, len(xs))
+	for i, x := range xs {
+		k := x
+		if s.collection.GetItem() != nil {
+			if k, err = s.buildItem(r, x, NewN(float64(i))); err != nil {
+				return nil, err
+			}
+		}
+		ps[i] = &pair{
+			key:   k,
+			value: x,
+		}
+	}
+	sort.SliceStable(ps, func(i, j int) bool {
+		if s.collection.GetDesc() {
+			return compareValue(ps[j].key, ps[i].key) < 0
+		}
+		return compareValue(ps[i].key, ps[j].key) < 0
+	})
+	p := make([]*Value, len(ps))
+	for i, x := range ps {
+		p[i] = x.value.Clone()
+	}
+	return NewL(p), nil
+}
+
+func listValues(v *Value) ([]*Value, error) {
+	if v.GetL() == nil {
+		return nil, errors.Newf(errors.InvalidArgument, "not list %s", util.JSON(v))
+	}
+	return v.GetL().GetValues(), nil
+}
+
+func containsValue(xs []*Value, v *Value) bool {
+	for _, x := range xs {
+		if proto.Equal(x, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]*Value) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mergeValueMap merges maps like util.MergeMap.
+func mergeValueMap(dest, other *Value) {
+	d := dest.GetM().GetValues()
+	if d == nil {
+		d = map[string]*Value{}
+		dest.GetM().Values = d
+	}
+	for k, v := range other.GetM().GetValues() {
+		if x, ok := d[k]; ok && x.GetM() != nil && v.GetM() != nil {
+			mergeValueMap(x, v)
+			continue
+		}
+		d[k] = v
+	}
+}
+
+// compareValue compares values in order of null, bool, number, string and the others.
+func compareValue(a, b *Value) int {
+	rank := func(v *Value) int {
+		switch v.GetValue().(type) {
+		case *Value_Null:
+			return 0
+		case *Value_B:
+			return 1
+		case *Value_N:
+			return 2
+		case *Value_S:
+			return 3
+		}
+		return 4
+	}
+	ra, rb := rank(a), rank(b)
+	if ra != rb {
+		return ra - rb
+	}
+	switch a.GetValue().(type) {
+	case *Value_B:
+		switch {
+		case a.GetB() == b.GetB():
+			return 0
+		case b.GetB():
+			return -1
+		default:
+			return 1
+		}
+	case *Value_N:
+		switch {
+		case a.GetN() < b.GetN():
+			return -1
+		case a.GetN() > b.GetN():
+			return 1
+		}
+	case *Value_S:
+		switch {
+		case a.GetS() < b.GetS():
+			return -1
+		case a.GetS() > b.GetS():
+			return 1
+		}
+	}
+	return 0
+}
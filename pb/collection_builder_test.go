@@ -0,0 +1,257 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestCollectionBuilder(t *testing.T) {
+	src := pb.NewTemplateSource(nil, nil, []byte(`{
+"items": [
+  {"id": 3, "name": "c", "tags": ["x"]},
+  {"id": 1, "name": "a", "tags": ["x", "y"]},
+  {"id": 2, "name": "b", "tags": []}
+],
+"nums": [3, 1, 3, 2, 1],
+"m": {"a": 1, "b": {"c": 2, "d": 3}, "e": 4}
+}`))
+	body := func(key string) string {
+		return `{"body":{"keys":["` + key + `"]}}`
+	}
+
+	for _, tc := range []*struct {
+		title      string
+		collection string
+		want       interface{}
+		isErr      bool
+	}{
+		{
+			title:      "len list",
+			collection: `{"type":"LEN","value":` + body("items") + `}`,
+			want:       3,
+		},
+		{
+			title:      "len map",
+			collection: `{"type":"LEN","value":` + body("m") + `}`,
+			want:       3,
+		},
+		{
+			title:      "len number",
+			collection: `{"type":"LEN","value":{"n":1}}`,
+			isErr:      true,
+		},
+		{
+			title:      "get index",
+			collection: `{"type":"GET","value":` + body("nums") + `,"args":[{"n":1}]}`,
+			want:       1,
+		},
+		{
+			title:      "get negative index",
+			collection: `{"type":"GET","value":` + body("nums") + `,"args":[{"n":-2}]}`,
+			want:       2,
+		},
+		{
+			title:      "get out of range",
+			collection: `{"type":"GET","value":` + body("nums") + `,"args":[{"n":5}]}`,
+			isErr:      true,
+		},
+		{
+			title:      "get key",
+			collection: `{"type":"GET","value":` + body("m") + `,"args":[{"s":"e"}]}`,
+			want:       4,
+		},
+		{
+			title:      "get no args",
+			collection: `{"type":"GET","value":` + body("m") + `}`,
+			isErr:      true,
+		},
+		{
+			title:      "slice",
+			collection: `{"type":"SLICE","value":` + body("nums") + `,"args":[{"n":1},{"n":-1}]}`,
+			want:       []interface{}{1, 3, 2},
+		},
+		{
+			title:      "slice from",
+			collection: `{"type":"SLICE","value":` + body("nums") + `,"args":[{"n":3}]}`,
+			want:       []interface{}{2, 1},
+		},
+		{
+			title:      "filter list",
+			collection: `{"type":"FILTER","value":` + body("items") + `,"item":{"expr":"len(item.tags) > 0"}}`,
+			want: []interface{}{
+				map[string]interface{}{"id": 3, "name": "c", "tags": []interface{}{"x"}},
+				map[string]interface{}{"id": 1, "name": "a", "tags": []interface{}{"x", "y"}},
+			},
+		},
+		{
+			title:      "filter map",
+			collection: `{"type":"FILTER","value":` + body("m") + `,"item":{"expr":"key != 'b'"}}`,
+			want:       map[string]interface{}{"a": 1, "e": 4},
+		},
+		{
+			title:      "filter without item",
+			collection: `{"type":"FILTER","value":` + body("m") + `}`,
+			isErr:      true,
+		},
+		{
+			title:      "map list",
+			collection: `{"type":"MAP","value":` + body("items") + `,"item":{"m":{"values":{"n":{"item":{"keys":["name"]}},"i":{"item":{"part":"KEY"}}}}}}`,
+			want: []interface{}{
+				map[string]interface{}{"n": "c", "i": 0},
+				map[string]interface{}{"n": "a", "i": 1},
+				map[string]interface{}{"n": "b", "i": 2},
+			},
+		},
+		{
+			title:      "map list by format out of range",
+			collection: `{"type":"MAP","value":` + body("items") + `,"item":{"format":"${item.name}-${item.tags.0}"}}`,
+			isErr:      true,
+		},
+		{
+			title:      "map map",
+			collection: `{"type":"MAP","value":{"m":{"values":{"x":{"n":1},"y":{"n":2}}}},"item":{"expr":"item * 10"}}`,
+			want:       map[string]interface{}{"x": 10, "y": 20},
+		},
+		{
+			title:      "sort",
+			collection: `{"type":"SORT","value":` + body("nums") + `}`,
+			want:       []interface{}{1, 1, 2, 3, 3},
+		},
+		{
+			title:      "sort by item desc",
+			collection: `{"type":"SORT","value":` + body("items") + `,"item":{"item":{"keys":["name"]}},"desc":true}`,
+			want: []interface{}{
+				map[string]interface{}{"id": 3, "name": "c", "tags": []interface{}{"x"}},
+				map[string]interface{}{"id": 2, "name": "b", "tags": []interface{}{}},
+				map[string]interface{}{"id": 1, "name": "a", "tags": []interface{}{"x", "y"}},
+			},
+		},
+		{
+			title:      "sort mixed",
+			collection: `{"type":"SORT","value":{"l":{"values":[{"s":"a"},{"n":1},{"null":null},{"b":true}]}}}`,
+			want:       []interface{}{nil, true, 1, "a"},
+		},
+		{
+			title:      "unique",
+			collection: `{"type":"UNIQUE","value":` + body("nums") + `}`,
+			want:       []interface{}{3, 1, 2},
+		},
+		{
+			title:      "merge",
+			collection: `{"type":"MERGE","value":` + body("m") + `,"args":[{"m":{"values":{"b":{"m":{"values":{"d":{"n":30}}}},"f":{"n":5}}}}]}`,
+			want: map[string]interface{}{
+				"a": 1,
+				"b": map[string]interface{}{"c": 2, "d": 30},
+				"e": 4,
+				"f": 5,
+			},
+		},
+		{
+			title:      "merge not map",
+			collection: `{"type":"MERGE","value":` + body("m") + `,"args":[{"n":1}]}`,
+			isErr:      true,
+		},
+		{
+			title:      "pick",
+			collection: `{"type":"PICK","value":` + body("m") + `,"args":[{"s":"a"},{"s":"e"},{"s":"z"}]}`,
+			want:       map[string]interface{}{"a": 1, "e": 4},
+		},
+		{
+			title:      "omit",
+			collection: `{"type":"OMIT","value":` + body("m") + `,"args":[{"s":"a"},{"s":"e"}]}`,
+			want: map[string]interface{}{
+				"b": map[string]interface{}{"c": 2, "d": 3},
+			},
+		},
+		{
+			title:      "keys",
+			collection: `{"type":"KEYS","value":` + body("m") + `}`,
+			want:       []interface{}{"a", "b", "e"},
+		},
+		{
+			title:      "values",
+			collection: `{"type":"VALUES","value":{"m":{"values":{"y":{"n":2},"x":{"n":1}}}}}`,
+			want:       []interface{}{1, 2},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var c pb.Value_Collection
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.collection), &c))
+			got, err := pb.NewCollectionBuilder(&c, pb.NewValueCaster(), handler.NewTemplateValueBuilder()).Build(src)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			v, err := pb.NewValueInverter().Invert(got)
+			assert.Nil(t, err)
+			assert.Equal(t, "", cmp.Diff(tc.want, v))
+		})
+	}
+}
+
+func TestItemBuilder(t *testing.T) {
+	item := pb.NewM(map[string]*pb.Value{
+		"tags": pb.NewL([]*pb.Value{
+			pb.NewS("x"),
+			pb.NewS("y"),
+		}),
+	})
+	src := pb.NewItemTemplateSource(pb.NewTemplateSource(nil, nil, nil), item, pb.NewN(1))
+
+	for _, tc := range []*struct {
+		title string
+		item  *pb.Value_Item
+		src   pb.TemplateSource
+		want  interface{}
+		isErr bool
+	}{
+		{
+			title: "not in collection",
+			item:  &pb.Value_Item{},
+			src:   pb.NewTemplateSource(nil, nil, nil),
+			isErr: true,
+		},
+		{
+			title: "key",
+			item: &pb.Value_Item{
+				Part: pb.Value_Item_KEY,
+			},
+			src:  src,
+			want: 1,
+		},
+		{
+			title: "value",
+			item: &pb.Value_Item{
+				Keys: []string{"tags", "-1"},
+			},
+			src:  src,
+			want: "y",
+		},
+		{
+			title: "not found",
+			item: &pb.Value_Item{
+				Keys: []string{"name"},
+			},
+			src:   src,
+			isErr: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got, err := pb.NewItemBuilder(tc.item).Build(tc.src)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			v, err := pb.NewValueInverter().Invert(got)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, v)
+		})
+	}
+}
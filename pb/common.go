@@ -51,4 +51,12 @@ func NewM(m map[string]*Value) *Value {
 	})
 }
 
+func newStringList(ss []string) *Value {
+	l := make([]*Value, len(ss))
+	for i, x := range ss {
+		l[i] = NewS(x)
+	}
+	return NewL(l)
+}
+
 func (s *Value) Clone() *Value { return proto.Clone(s).(*Value) }
@@ -0,0 +1,74 @@
+package pb
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+// DumpBuilder extracts the whole of a part of http request.
+type DumpBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewDumpBuilder(dump *Value_Dump, valueConverter ValueConverter) DumpBuilder {
+	return &dumpBuilder{
+		dump:           dump,
+		valueConverter: valueConverter,
+	}
+}
+
+type dumpBuilder struct {
+	dump           *Value_Dump
+	valueConverter ValueConverter
+}
+
+func (s *dumpBuilder) Build(r TemplateSource) (*Value, error) {
+	switch s.dump.GetTarget() {
+	case Value_Dump_BODY:
+		var v interface{}
+		if err := json.Unmarshal(r.Body(), &v); err != nil {
+			return nil, errors.Wrap(err, errors.InvalidArgument, "dump builder accept only json")
+		}
+		x, err := s.valueConverter.Convert(v)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InvalidArgument, "dump builder cannot convert body")
+		}
+		return x, nil
+	case Value_Dump_HEADER:
+		if r.Header() == nil {
+			return NewM(map[string]*Value{}), nil
+		}
+		return s.multiMap(*r.Header()), nil
+	case Value_Dump_QUERY:
+		if r.URL() == nil {
+			return NewM(map[string]*Value{}), nil
+		}
+		return s.multiMap(r.URL().Query()), nil
+	case Value_Dump_COOKIE:
+		d := map[string]*Value{}
+		if r.Header() != nil {
+			for _, c := range (&http.Request{Header: *r.Header()}).Cookies() {
+				d[c.Name] = NewS(c.Value)
+			}
+		}
+		return NewM(d), nil
+	}
+	return nil, errors.Newf(errors.UnknownError, "dump builder %s", s.dump.GetTarget())
+}
+
+func (s *dumpBuilder) multiMap(m map[string][]string) *Value {
+	d := map[string]*Value{}
+	for k, vs := range m {
+		if len(vs) == 0 {
+			continue
+		}
+		if s.dump.GetAll() {
+			d[k] = newStringList(vs)
+			continue
+		}
+		d[k] = NewS(vs[0])
+	}
+	return NewM(d)
+}
@@ -0,0 +1,104 @@
+package pb_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpBuilder(t *testing.T) {
+	u, err := url.Parse("http://localhost/a?tag=x&tag=y&id=1")
+	assert.Nil(t, err)
+	src := pb.NewTemplateSource(u, &http.Header{
+		"Vary":   []string{"Accept", "Origin"},
+		"Cookie": []string{"sid=abc; lang=ja"},
+	}, []byte(`{"a":[1,{"b":true}]}`))
+
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			dump  *pb.Value_Dump
+			src   pb.TemplateSource
+			want  interface{}
+			isErr bool
+		}{
+			{
+				title: "body",
+				dump:  &pb.Value_Dump{},
+				src:   src,
+				want: map[string]interface{}{
+					"a": []interface{}{
+						1,
+						map[string]interface{}{
+							"b": true,
+						},
+					},
+				},
+			},
+			{
+				title: "not json body",
+				dump:  &pb.Value_Dump{},
+				src:   pb.NewTemplateSource(nil, nil, []byte(`text`)),
+				isErr: true,
+			},
+			{
+				title: "header",
+				dump: &pb.Value_Dump{
+					Target: pb.Value_Dump_HEADER,
+				},
+				src: src,
+				want: map[string]interface{}{
+					"Vary":   "Accept",
+					"Cookie": "sid=abc; lang=ja",
+				},
+			},
+			{
+				title: "all query",
+				dump: &pb.Value_Dump{
+					Target: pb.Value_Dump_QUERY,
+					All:    true,
+				},
+				src: src,
+				want: map[string]interface{}{
+					"tag": []interface{}{"x", "y"},
+					"id":  []interface{}{"1"},
+				},
+			},
+			{
+				title: "no query",
+				dump: &pb.Value_Dump{
+					Target: pb.Value_Dump_QUERY,
+				},
+				src:  pb.NewTemplateSource(nil, nil, nil),
+				want: map[string]interface{}{},
+			},
+			{
+				title: "cookie",
+				dump: &pb.Value_Dump{
+					Target: pb.Value_Dump_COOKIE,
+				},
+				src: src,
+				want: map[string]interface{}{
+					"sid":  "abc",
+					"lang": "ja",
+				},
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewDumpBuilder(tc.dump, pb.NewValueConverter()).Build(tc.src)
+				if tc.isErr {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				v, err := pb.NewValueInverter().Invert(got)
+				assert.Nil(t, err)
+				assert.Equal(t, "", cmp.Diff(tc.want, v))
+			})
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package pb
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+)
+
+// EncodeBuilder encodes a string.
+type EncodeBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewEncodeBuilder(encode *Value_Encode, valueCaster ValueCaster, templateValueBuilder TemplateValueBuilder) EncodeBuilder {
+	return &encodeBuilder{
+		encode:               encode,
+		valueCaster:          valueCaster,
+		templateValueBuilder: templateValueBuilder,
+	}
+}
+
+type encodeBuilder struct {
+	encode               *Value_Encode
+	valueCaster          ValueCaster
+	templateValueBuilder TemplateValueBuilder
+}
+
+func (s *encodeBuilder) Build(r TemplateSource) (*Value, error) {
+	x, err := buildString(s.encode.GetValue(), r, s.valueCaster, s.templateValueBuilder)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build encode %s", s.encode.GetType())
+	}
+	v, err := encode(s.encode.GetType(), []byte(x))
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build encode %s", s.encode.GetType())
+	}
+	return NewS(v), nil
+}
+
+// DecodeBuilder decodes a string.
+type DecodeBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewDecodeBuilder(decode *Value_Decode, valueCaster ValueCaster, templateValueBuilder TemplateValueBuilder) DecodeBuilder {
+	return &decodeBuilder{
+		decode:               decode,
+		valueCaster:          valueCaster,
+		templateValueBuilder: templateValueBuilder,
+	}
+}
+
+type decodeBuilder struct {
+	decode               *Value_Decode
+	valueCaster          ValueCaster
+	templateValueBuilder TemplateValueBuilder
+}
+
+func (s *decodeBuilder) Build(r TemplateSource) (*Value, error) {
+	x, err := buildString(s.decode.GetValue(), r, s.valueCaster, s.templateValueBuilder)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build decode %s", s.decode.GetType())
+	}
+	v, err := decode(s.decode.GetType(), x)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build decode %s %s", s.decode.GetType(), x)
+	}
+	// the string value is written as json
+	if !utf8.Valid(v) {
+		return nil, errors.Newf(errors.InvalidValue, "cannot build decode %s %s, not utf-8", s.decode.GetType(), x)
+	}
+	return NewS(string(v)), nil
+}
+
+func buildString(value *Value, r TemplateSource, valueCaster ValueCaster, templateValueBuilder TemplateValueBuilder) (string, error) {
+	b, err := templateValueBuilder.Build(value, r)
+	if err != nil {
+		return "", errors.Wrapf(err, errors.InvalidValue, "cannot build %s", util.JSON(value))
+	}
+	x, err := valueCaster.String(b)
+	if err != nil {
+		return "", errors.Wrapf(err, errors.TypeCast, "cannot build %s", util.JSON(b))
+	}
+	return x, nil
+}
+
+func encode(t Value_Encode_Type, b []byte) (string, error) {
+	switch t {
+	case Value_Encode_HEX:
+		return hex.EncodeToString(b), nil
+	case Value_Encode_BASE64:
+		return base64.StdEncoding.EncodeToString(b), nil
+	case Value_Encode_BASE64_URL:
+		return base64.RawURLEncoding.EncodeToString(b), nil
+	case Value_Encode_URL:
+		return url.QueryEscape(string(b)), nil
+	}
+	return "", errors.Newf(errors.UnknownError, "encode %s", t)
+}
+
+func decode(t Value_Encode_Type, s string) ([]byte, error) {
+	switch t {
+	case Value_Encode_HEX:
+		return hex.DecodeString(s)
+	case Value_Encode_BASE64:
+		return base64.StdEncoding.DecodeString(s)
+	case Value_Encode_BASE64_URL:
+		// accept the padded form too
+		return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	case Value_Encode_URL:
+		x, err := url.QueryUnescape(s)
+		return []byte(x), err
+	}
+	return nil, errors.Newf(errors.UnknownError, "decode %s", t)
+}
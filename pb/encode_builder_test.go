@@ -0,0 +1,149 @@
+package pb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		t.Run("template error", func(t *testing.T) {
+			_, err := pb.NewEncodeBuilder(&pb.Value_Encode{
+				Value: pb.NewS("x"),
+			}, pb.NewValueCaster(), &mockTemplateValueBuilder{
+				err: fmt.Errorf("template value build error"),
+			}).Build(nil)
+			assert.NotNil(t, err)
+		})
+		t.Run("not string", func(t *testing.T) {
+			_, err := pb.NewEncodeBuilder(&pb.Value_Encode{
+				Value: pb.NewL(nil),
+			}, pb.NewValueCaster(), &mockTemplateValueBuilder{}).Build(nil)
+			assert.NotNil(t, err)
+		})
+
+		for _, tc := range []*struct {
+			title string
+			typ   pb.Value_Encode_Type
+			value string
+			want  string
+		}{
+			{
+				title: "hex",
+				typ:   pb.Value_Encode_HEX,
+				value: "abc",
+				want:  "616263",
+			},
+			{
+				title: "base64",
+				typ:   pb.Value_Encode_BASE64,
+				value: "\xfb\xff?",
+				want:  "+/8/",
+			},
+			{
+				title: "base64 url",
+				typ:   pb.Value_Encode_BASE64_URL,
+				value: "\xfb\xff?a",
+				want:  "-_8_YQ",
+			},
+			{
+				title: "url",
+				typ:   pb.Value_Encode_URL,
+				value: "a b&c=d",
+				want:  "a+b%26c%3Dd",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewEncodeBuilder(&pb.Value_Encode{
+					Type:  tc.typ,
+					Value: pb.NewS(tc.value),
+				}, pb.NewValueCaster(), &mockTemplateValueBuilder{}).Build(nil)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, got.GetS())
+			})
+		}
+	})
+}
+
+func TestDecodeBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			typ   pb.Value_Encode_Type
+			value string
+			want  string
+			isErr bool
+		}{
+			{
+				title: "hex",
+				typ:   pb.Value_Encode_HEX,
+				value: "616263",
+				want:  "abc",
+			},
+			{
+				title: "invalid hex",
+				typ:   pb.Value_Encode_HEX,
+				value: "xyz",
+				isErr: true,
+			},
+			{
+				title: "base64",
+				typ:   pb.Value_Encode_BASE64,
+				value: "YcO/w6k+",
+				want:  "aÿé>",
+			},
+			{
+				title: "base64 not utf-8",
+				typ:   pb.Value_Encode_BASE64,
+				value: "+/8/",
+				isErr: true,
+			},
+			{
+				title: "invalid base64",
+				typ:   pb.Value_Encode_BASE64,
+				value: "-_8_",
+				isErr: true,
+			},
+			{
+				title: "base64 url",
+				typ:   pb.Value_Encode_BASE64_URL,
+				value: "YcO_w6k-YQ",
+				want:  "aÿé>a",
+			},
+			{
+				title: "padded base64 url",
+				typ:   pb.Value_Encode_BASE64_URL,
+				value: "YcO_w6k-YQ==",
+				want:  "aÿé>a",
+			},
+			{
+				title: "hex not utf-8",
+				typ:   pb.Value_Encode_HEX,
+				value: "fffe",
+				isErr: true,
+			},
+			{
+				title: "url",
+				typ:   pb.Value_Encode_URL,
+				value: "a+b%26c%3Dd",
+				want:  "a b&c=d",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewDecodeBuilder(&pb.Value_Decode{
+					Type:  tc.typ,
+					Value: pb.NewS(tc.value),
+				}, pb.NewValueCaster(), &mockTemplateValueBuilder{}).Build(nil)
+				if tc.isErr {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, got.GetS())
+			})
+		}
+	})
+}
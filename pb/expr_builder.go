@@ -0,0 +1,180 @@
+package pb
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/expr"
+)
+
+// ExprBuilder evaluates an expression.
+type ExprBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+// ExprParser parses expressions.
+type ExprParser interface {
+	Parse(src string) (expr.Program, error)
+}
+
+// NewExprParser returns a new parser caching the programs by source.
+func NewExprParser() ExprParser {
+	return &exprParser{}
+}
+
+type exprParser struct {
+	programs sync.Map
+}
+
+func (s *exprParser) Parse(src string) (expr.Program, error) {
+	if p, ok := s.programs.Load(src); ok {
+		return p.(expr.Program), nil
+	}
+	p, err := expr.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	s.programs.Store(src, p)
+	return p, nil
+}
+
+func NewExprBuilder(src string, exprParser ExprParser, valueConverter ValueConverter) ExprBuilder {
+	return &exprBuilder{
+		src:            src,
+		exprParser:     exprParser,
+		valueConverter: valueConverter,
+	}
+}
+
+type exprBuilder struct {
+	src            string
+	exprParser     ExprParser
+	valueConverter ValueConverter
+}
+
+func (s *exprBuilder) Build(r TemplateSource) (*Value, error) {
+	p, err := s.exprParser.Parse(s.src)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InvalidSettings, "expr builder")
+	}
+	x, err := p.Eval(newExprEnv(r))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InvalidValue, "expr builder")
+	}
+	v, err := s.valueConverter.Convert(x)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "expr builder cannot convert %v", x)
+	}
+	return v, nil
+}
+
+// newExprEnv returns the names available in expressions.
+func newExprEnv(r TemplateSource) map[string]interface{} {
+	var (
+		header = map[string]interface{}{}
+		query  = map[string]interface{}{}
+		cookie = map[string]interface{}{}
+		body   interface{}
+		path   string
+	)
+	if h := r.Header(); h != nil {
+		header = firstValues(h)
+		for _, c := range (&http.Request{Header: *h}).Cookies() {
+			cookie[c.Name] = c.Value
+		}
+	}
+	if u := r.URL(); u != nil {
+		for k, v := range u.Query() {
+			if len(v) > 0 {
+				query[k] = v[0]
+			}
+		}
+		path = u.Path
+	}
+	if err := json.Unmarshal(r.Body(), &body); err != nil {
+		body = nil
+	}
+	env := map[string]interface{}{
+		"header": header,
+		"query":  query,
+		"body":   body,
+		"path":   path,
+		"now":    float64(time.Now().Unix()),
+		"cookie": cookie,
+
+		"method":        r.Method(),
+		"remoteAddr":    r.RemoteAddr(),
+		"proto":         r.Proto(),
+		"contentLength": float64(r.ContentLength()),
+		"requestId":     r.RequestID(),
+	}
+	if xs := r.Upstreams(); len(xs) > 0 {
+		upstream := map[string]interface{}{}
+		for name, x := range xs {
+			var body interface{}
+			if err := json.Unmarshal(x.Body(), &body); err != nil {
+				body = nil
+			}
+			upstream[name] = map[string]interface{}{
+				"status": float64(x.Status()),
+				"header": firstValues(x.Header()),
+				"body":   body,
+			}
+		}
+		env["upstream"] = upstream
+	}
+	if xs := r.PathParams(); len(xs) > 0 {
+		param := map[string]interface{}{}
+		for k, v := range xs {
+			param[k] = v
+		}
+		env["param"] = param
+	}
+	if x, ok := r.(ItemTemplateSource); ok {
+		env["item"] = plainValue(x.Item())
+		env["key"] = plainValue(x.Key())
+	}
+	return env
+}
+
+// firstValues returns the first value of each header.
+func firstValues(h *http.Header) map[string]interface{} {
+	d := map[string]interface{}{}
+	if h == nil {
+		return d
+	}
+	for k, v := range *h {
+		if len(v) > 0 {
+			d[k] = v[0]
+		}
+	}
+	return d
+}
+
+// plainValue translates Value into the value of the expression.
+func plainValue(v *Value) interface{} {
+	switch v.GetValue().(type) {
+	case *Value_B:
+		return v.GetB()
+	case *Value_N:
+		return v.GetN()
+	case *Value_S:
+		return v.GetS()
+	case *Value_L:
+		p := make([]interface{}, len(v.GetL().GetValues()))
+		for i, x := range v.GetL().GetValues() {
+			p[i] = plainValue(x)
+		}
+		return p
+	case *Value_M:
+		p := map[string]interface{}{}
+		for k, x := range v.GetM().GetValues() {
+			p[k] = plainValue(x)
+		}
+		return p
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package pb_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExprBuilder(t *testing.T) {
+	u, err := url.Parse("http://localhost/users/10?page=2")
+	assert.Nil(t, err)
+	src := pb.NewTemplateSource(u, &http.Header{
+		"X-Token": []string{"secret"},
+	}, []byte(`{"items":[{"id":1},{"id":2}]}`))
+
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			expr  string
+			want  interface{}
+			isErr bool
+		}{
+			{
+				title: "parse error",
+				expr:  "1 +",
+				isErr: true,
+			},
+			{
+				title: "eval error",
+				expr:  "1 / 0",
+				isErr: true,
+			},
+			{
+				title: "body",
+				expr:  "body.items[1].id",
+				want:  2.0,
+			},
+			{
+				title: "query",
+				expr:  "query.page * 10",
+				want:  20.0,
+			},
+			{
+				title: "header",
+				expr:  `header["X-Token"] == "secret"`,
+				want:  true,
+			},
+			{
+				title: "path",
+				expr:  `split(path, "/")[-1]`,
+				want:  "10",
+			},
+			{
+				title: "now",
+				expr:  "now > 0",
+				want:  true,
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewExprBuilder(tc.expr, pb.NewExprParser(), pb.NewValueConverter()).Build(src)
+				if tc.isErr {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				v, err := pb.NewValueInverter().Invert(got)
+				assert.Nil(t, err)
+				if n, ok := v.(int); ok {
+					v = float64(n)
+				}
+				assert.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestExprParser(t *testing.T) {
+	p := pb.NewExprParser()
+	x, err := p.Parse("1 + 2")
+	assert.Nil(t, err)
+	y, err := p.Parse("1 + 2")
+	assert.Nil(t, err)
+	assert.Same(t, x, y)
+	_, err = p.Parse("1 +")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,216 @@
+package pb
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+// FormatBuilder interpolates placeholders in a string.
+type FormatBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+// FormatParser parses format strings.
+type FormatParser interface {
+	Parse(format string) (*Value, error)
+}
+
+// NewFormatParser returns a new parser caching the values by format.
+func NewFormatParser() FormatParser {
+	return &formatParser{}
+}
+
+type formatParser struct {
+	values sync.Map
+}
+
+func (s *formatParser) Parse(format string) (*Value, error) {
+	if v, ok := s.values.Load(format); ok {
+		return v.(*Value), nil
+	}
+	v, err := ParseFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	s.values.Store(format, v)
+	return v, nil
+}
+
+func NewFormatBuilder(format string, formatParser FormatParser, templateValueBuilder TemplateValueBuilder) FormatBuilder {
+	return &formatBuilder{
+		format:               format,
+		formatParser:         formatParser,
+		templateValueBuilder: templateValueBuilder,
+	}
+}
+
+type formatBuilder struct {
+	format               string
+	formatParser         FormatParser
+	templateValueBuilder TemplateValueBuilder
+}
+
+func (s *formatBuilder) Build(r TemplateSource) (*Value, error) {
+	v, err := s.formatParser.Parse(s.format)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidSettings, "cannot parse format %s", s.format)
+	}
+	x, err := s.templateValueBuilder.Build(v, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build format %s", s.format)
+	}
+	return x, nil
+}
+
+// ParseFormat translates a format string into the Add of STRING
+// that concatenates the literals and the placeholder values, the empty string if the format is empty.
+func ParseFormat(format string) (*Value, error) {
+	var (
+		values []*Value
+		lit    strings.Builder
+		flush  = func() {
+			if lit.Len() > 0 {
+				values = append(values, NewS(lit.String()))
+				lit.Reset()
+			}
+		}
+	)
+	for i := 0; i < len(format); i++ {
+		if format[i] != '$' || i+1 >= len(format) {
+			lit.WriteByte(format[i])
+			continue
+		}
+		switch format[i+1] {
+		case '$':
+			lit.WriteByte('$')
+			i++
+		case '{':
+			end := strings.IndexByte(format[i+2:], '}')
+			if end < 0 {
+				return nil, errors.Newf(errors.InvalidSettings, "unterminated placeholder at %d", i)
+			}
+			v, err := parsePlaceholder(format[i+2 : i+2+end])
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidSettings, "invalid placeholder at %d", i)
+			}
+			flush()
+			values = append(values, v)
+			i += end + 2
+		default:
+			lit.WriteByte('$')
+		}
+	}
+	flush()
+	if len(values) == 0 {
+		return NewS(""), nil
+	}
+	return newValue(&Value_Add_{
+		Add: &Value_Add{
+			Type:   Value_Add_STRING,
+			Values: values,
+		},
+	}), nil
+}
+
+func parsePlaceholder(p string) (*Value, error) {
+	if p == "item" {
+		return newValue(&Value_Item_{
+			Item: &Value_Item{},
+		}), nil
+	}
+	xs := strings.SplitN(p, ".", 2)
+	if len(xs) != 2 || xs[1] == "" {
+		return nil, errors.Newf(errors.InvalidSettings, "placeholder %s", p)
+	}
+	source, key := xs[0], xs[1]
+	switch source {
+	case "body":
+		return newValue(&Value_Body_{
+			Body: &Value_Body{
+				Keys: strings.Split(key, "."),
+			},
+		}), nil
+	case "header":
+		return newValue(&Value_Header_{
+			Header: &Value_Header{
+				Key: key,
+			},
+		}), nil
+	case "query":
+		return newValue(&Value_Url_{
+			Url: &Value_Url{
+				Value: &Value_Url_Query_{
+					Query: &Value_Url_Query{
+						Key: key,
+					},
+				},
+			},
+		}), nil
+	case "path":
+		i, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidSettings, "path index %s", key)
+		}
+		return newValue(&Value_Url_{
+			Url: &Value_Url{
+				Value: &Value_Url_Path_{
+					Path: &Value_Url_Path{
+						Index: int32(i),
+					},
+				},
+			},
+		}), nil
+	case "url":
+		part, ok := Value_Url_Part_value[strings.ToUpper(key)]
+		if !ok {
+			return nil, errors.Newf(errors.InvalidSettings, "url part %s", key)
+		}
+		return newValue(&Value_Url_{
+			Url: &Value_Url{
+				Value: &Value_Url_Part_{
+					Part: Value_Url_Part(part),
+				},
+			},
+		}), nil
+	case "request":
+		part, ok := Value_Request_Part_value[strings.ToUpper(key)]
+		if !ok {
+			return nil, errors.Newf(errors.InvalidSettings, "request part %s", key)
+		}
+		return newValue(&Value_Request_{
+			Request: &Value_Request{
+				Part: Value_Request_Part(part),
+			},
+		}), nil
+	case "item":
+		return newValue(&Value_Item_{
+			Item: &Value_Item{
+				Keys: strings.Split(key, "."),
+			},
+		}), nil
+	case "upstream":
+		ks := strings.Split(key, ".")
+		return newValue(&Value_Upstream_{
+			Upstream: &Value_Upstream{
+				Name: ks[0],
+				Keys: ks[1:],
+			},
+		}), nil
+	case "param":
+		return newValue(&Value_Param_{
+			Param: &Value_Param{
+				Name: key,
+			},
+		}), nil
+	case "cookie":
+		return newValue(&Value_Cookie_{
+			Cookie: &Value_Cookie{
+				Key: key,
+			},
+		}), nil
+	}
+	return nil, errors.Newf(errors.InvalidSettings, "unknown placeholder source %s", source)
+}
@@ -0,0 +1,189 @@
+package pb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseFormat(t *testing.T) {
+	newAdd := func(values ...*pb.Value) *pb.Value {
+		return &pb.Value{
+			Value: &pb.Value_Add_{
+				Add: &pb.Value_Add{
+					Type:   pb.Value_Add_STRING,
+					Values: values,
+				},
+			},
+		}
+	}
+	newURL := func(u *pb.Value_Url) *pb.Value {
+		return &pb.Value{
+			Value: &pb.Value_Url_{
+				Url: u,
+			},
+		}
+	}
+
+	for _, tc := range []*struct {
+		title  string
+		format string
+		want   *pb.Value
+		isErr  bool
+	}{
+		{
+			title: "empty",
+			want:  pb.NewS(""),
+		},
+		{
+			title:  "literal",
+			format: "hello",
+			want:   newAdd(pb.NewS("hello")),
+		},
+		{
+			title:  "escape",
+			format: "$$1 and $2$",
+			want:   newAdd(pb.NewS("$1 and $2$")),
+		},
+		{
+			title:  "unterminated",
+			format: "${body.x",
+			isErr:  true,
+		},
+		{
+			title:  "unknown source",
+			format: "${session.x}",
+			isErr:  true,
+		},
+		{
+			title:  "no key",
+			format: "${body}",
+			isErr:  true,
+		},
+		{
+			title:  "invalid path index",
+			format: "${path.x}",
+			isErr:  true,
+		},
+		{
+			title:  "invalid url part",
+			format: "${url.user}",
+			isErr:  true,
+		},
+		{
+			title:  "invalid request part",
+			format: "${request.user}",
+			isErr:  true,
+		},
+		{
+			title:  "request and cookie",
+			format: "${request.remote_host}${cookie.sid}",
+			want: newAdd(
+				&pb.Value{
+					Value: &pb.Value_Request_{
+						Request: &pb.Value_Request{
+							Part: pb.Value_Request_REMOTE_HOST,
+						},
+					},
+				},
+				&pb.Value{
+					Value: &pb.Value_Cookie_{
+						Cookie: &pb.Value_Cookie{
+							Key: "sid",
+						},
+					},
+				},
+			),
+		},
+		{
+			title:  "placeholders",
+			format: "http://${url.host}:${body.port.n}/${path.1}?id=${query.id}&t=${header.X-Token}",
+			want: newAdd(
+				pb.NewS("http://"),
+				newURL(&pb.Value_Url{
+					Value: &pb.Value_Url_Part_{
+						Part: pb.Value_Url_HOST,
+					},
+				}),
+				pb.NewS(":"),
+				&pb.Value{
+					Value: &pb.Value_Body_{
+						Body: &pb.Value_Body{
+							Keys: []string{"port", "n"},
+						},
+					},
+				},
+				pb.NewS("/"),
+				newURL(&pb.Value_Url{
+					Value: &pb.Value_Url_Path_{
+						Path: &pb.Value_Url_Path{
+							Index: 1,
+						},
+					},
+				}),
+				pb.NewS("?id="),
+				newURL(&pb.Value_Url{
+					Value: &pb.Value_Url_Query_{
+						Query: &pb.Value_Url_Query{
+							Key: "id",
+						},
+					},
+				}),
+				pb.NewS("&t="),
+				&pb.Value{
+					Value: &pb.Value_Header_{
+						Header: &pb.Value_Header{
+							Key: "X-Token",
+						},
+					},
+				},
+			),
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			got, err := pb.ParseFormat(tc.format)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "", cmp.Diff(tc.want, got, protocmp.Transform()))
+		})
+	}
+}
+
+func TestFormatBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		t.Run("parse error", func(t *testing.T) {
+			_, err := pb.NewFormatBuilder("${", pb.NewFormatParser(), &mockTemplateValueBuilder{}).Build(nil)
+			assert.NotNil(t, err)
+		})
+		t.Run("template error", func(t *testing.T) {
+			_, err := pb.NewFormatBuilder("x", pb.NewFormatParser(), &mockTemplateValueBuilder{
+				err: fmt.Errorf("template value build error"),
+			}).Build(nil)
+			assert.NotNil(t, err)
+		})
+		t.Run("build add", func(t *testing.T) {
+			got, err := pb.NewFormatBuilder("x", pb.NewFormatParser(), &mockTemplateValueBuilder{}).Build(nil)
+			assert.Nil(t, err)
+			assert.Equal(t, pb.Value_Add_STRING, got.GetAdd().GetType())
+			assert.Equal(t, "x", got.GetAdd().GetValues()[0].GetS())
+		})
+	})
+}
+
+func TestFormatParser(t *testing.T) {
+	p := pb.NewFormatParser()
+	x, err := p.Parse("id=${query.id}")
+	assert.Nil(t, err)
+	y, err := p.Parse("id=${query.id}")
+	assert.Nil(t, err)
+	assert.Same(t, x, y)
+	_, err = p.Parse("${")
+	assert.NotNil(t, err)
+}
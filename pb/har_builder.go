@@ -0,0 +1,62 @@
+package pb
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/har"
+)
+
+// HARBuilder builds a handler replaying an entry of a HAR archive.
+type HARBuilder interface {
+	Build(entry *har.Entry) (*Handler, error)
+}
+
+func NewHARBuilder(h *Har, recordBF func(*Record) RecordBuilder) HARBuilder {
+	return &harBuilder{
+		har:      h,
+		recordBF: recordBF,
+	}
+}
+
+type harBuilder struct {
+	har      *Har
+	recordBF func(*Record) RecordBuilder
+}
+
+func (s *harBuilder) Build(entry *har.Entry) (*Handler, error) {
+	r, body, err := entry.HTTPRequest()
+	if err != nil {
+		return nil, err
+	}
+	status, header, resBody, err := entry.HTTPResponse()
+	if err != nil {
+		return nil, err
+	}
+	record := &Record{
+		ResponseHeaders: s.har.GetResponseHeaders(),
+	}
+	for k := range r.URL.Query() {
+		record.Queries = append(record.Queries, k)
+	}
+	if s.har.GetMatchBody() {
+		var b map[string]interface{}
+		if err := json.Unmarshal(body, &b); err == nil {
+			for k := range b {
+				record.Body = append(record.Body, k)
+			}
+		}
+	}
+	sort.Strings(record.Queries)
+	sort.Strings(record.Body)
+	h, err := s.recordBF(record).Build(r, body, NewUpstreamResponse(status, &header, resBody))
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "har entry %s %s", r.Method, r.URL)
+	}
+	if h.Match == nil {
+		h.Match = &Match{}
+	}
+	h.Match.Closest = true
+	return h, nil
+}
@@ -0,0 +1,85 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/internal/har"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestHARBuilder(t *testing.T) {
+	newEntry := func(t *testing.T, entry string) *har.Entry {
+		h, err := har.Parse([]byte(`{"log":{"entries":[` + entry + `]}}`))
+		assert.Nil(t, err)
+		return h.Log.Entries[0]
+	}
+	recordBF := func(r *pb.Record) pb.RecordBuilder {
+		return pb.NewRecordBuilder(r, pb.NewValueConverter())
+	}
+	const postEntry = `{
+"request":{"method":"POST","url":"https://example.com/users?id=1&type=admin","headers":[],"queryString":[],
+"postData":{"mimeType":"application/json","text":"{\"name\":\"alice\",\"age\":20}"}},
+"response":{"status":201,"headers":[],"content":{"mimeType":"application/json","text":"{\"id\":1}"}}
+}`
+
+	for _, tc := range []*struct {
+		title string
+		har   string
+		entry string
+		want  string
+		isErr bool
+	}{
+		{
+			title: "match query",
+			har:   `{"file":"a.har"}`,
+			entry: postEntry,
+			want: `{"path":"/users","methodType":"POST","match":{"queries":{"id":"1","type":"admin"},"closest":true},
+"action":{"return":{"status":201,"templates":[
+{"type":"HEADER","value":{"m":{"values":{"Content-Type":{"s":"application/json"}}}}},
+{"value":{"m":{"values":{"id":{"n":1}}}}}
+]}}}`,
+		},
+		{
+			title: "match body",
+			har:   `{"file":"a.har","matchBody":true,"responseHeaders":["X-Total"]}`,
+			entry: postEntry,
+			want: `{"path":"/users","methodType":"POST","match":{
+"queries":{"id":"1","type":"admin"},
+"body":{"name":{"s":"alice"},"age":{"n":20}},
+"closest":true
+},"action":{"return":{"status":201,"templates":[
+{"value":{"m":{"values":{"id":{"n":1}}}}}
+]}}}`,
+		},
+		{
+			title: "no conditions",
+			har:   `{"file":"a.har"}`,
+			entry: `{"request":{"method":"GET","url":"https://example.com/hello"},"response":{"status":200,"content":{"text":"hello"}}}`,
+			want:  `{"path":"/hello","match":{"closest":true},"action":{"return":{"status":200,"raw":"hello"}}}`,
+		},
+		{
+			title: "binary",
+			har:   `{"file":"a.har"}`,
+			entry: `{"request":{"method":"GET","url":"https://example.com/a.png"},"response":{"status":200,"content":{"text":"/w==","encoding":"base64"}}}`,
+			want:  `{"path":"/a.png","match":{"closest":true},"action":{"return":{"status":200,"rawBytes":"/w=="}}}`,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var h pb.Har
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.har), &h))
+			got, err := pb.NewHARBuilder(&h, recordBF).Build(newEntry(t, tc.entry))
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			var want pb.Handler
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.want), &want))
+			assert.Equal(t, "", cmp.Diff(&want, got, protocmp.Transform()))
+		})
+	}
+}
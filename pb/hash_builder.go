@@ -0,0 +1,72 @@
+package pb
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+// HashBuilder calculates a digest or HMAC of a string.
+type HashBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewHashBuilder(hash *Value_Hash, valueCaster ValueCaster, templateValueBuilder TemplateValueBuilder) HashBuilder {
+	return &hashBuilder{
+		hash:                 hash,
+		valueCaster:          valueCaster,
+		templateValueBuilder: templateValueBuilder,
+	}
+}
+
+type hashBuilder struct {
+	hash                 *Value_Hash
+	valueCaster          ValueCaster
+	templateValueBuilder TemplateValueBuilder
+}
+
+func (s *hashBuilder) Build(r TemplateSource) (*Value, error) {
+	newHash, err := s.newHash()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InvalidSettings, "cannot build hash")
+	}
+	x, err := buildString(s.hash.GetValue(), r, s.valueCaster, s.templateValueBuilder)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build hash %s", s.hash.GetType())
+	}
+	var h hash.Hash
+	if s.hash.GetKey() != nil {
+		k, err := buildString(s.hash.GetKey(), r, s.valueCaster, s.templateValueBuilder)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build hmac key %s", s.hash.GetType())
+		}
+		h = hmac.New(newHash, []byte(k))
+	} else {
+		h = newHash()
+	}
+	_, _ = h.Write([]byte(x))
+	v, err := encode(s.hash.GetEncoding(), h.Sum(nil))
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build hash %s", s.hash.GetType())
+	}
+	return NewS(v), nil
+}
+
+func (s *hashBuilder) newHash() (func() hash.Hash, error) {
+	switch s.hash.GetType() {
+	case Value_Hash_MD5:
+		return md5.New, nil
+	case Value_Hash_SHA1:
+		return sha1.New, nil
+	case Value_Hash_SHA256:
+		return sha256.New, nil
+	case Value_Hash_SHA512:
+		return sha512.New, nil
+	}
+	return nil, errors.Newf(errors.UnknownError, "hash %s", s.hash.GetType())
+}
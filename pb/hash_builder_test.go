@@ -0,0 +1,67 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			hash  *pb.Value_Hash
+			want  string
+		}{
+			{
+				title: "md5",
+				hash: &pb.Value_Hash{
+					Type:  pb.Value_Hash_MD5,
+					Value: pb.NewS("abc"),
+				},
+				want: "900150983cd24fb0d6963f7d28e17f72",
+			},
+			{
+				title: "sha1",
+				hash: &pb.Value_Hash{
+					Type:  pb.Value_Hash_SHA1,
+					Value: pb.NewS("abc"),
+				},
+				want: "a9993e364706816aba3e25717850c26c9cd0d89d",
+			},
+			{
+				title: "sha256",
+				hash: &pb.Value_Hash{
+					Type:  pb.Value_Hash_SHA256,
+					Value: pb.NewS("abc"),
+				},
+				want: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+			},
+			{
+				title: "sha256 base64",
+				hash: &pb.Value_Hash{
+					Type:     pb.Value_Hash_SHA256,
+					Value:    pb.NewS("abc"),
+					Encoding: pb.Value_Encode_BASE64,
+				},
+				want: "ungWv48Bz+pBQUDeXa4iI7ADYaOWF3qctBD/YfIAFa0=",
+			},
+			{
+				title: "hmac sha256",
+				hash: &pb.Value_Hash{
+					Type:  pb.Value_Hash_SHA256,
+					Value: pb.NewS("The quick brown fox jumps over the lazy dog"),
+					Key:   pb.NewS("key"),
+				},
+				want: "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewHashBuilder(tc.hash, pb.NewValueCaster(), &mockTemplateValueBuilder{}).Build(nil)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, got.GetS())
+			})
+		}
+	})
+}
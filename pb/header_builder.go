@@ -25,6 +25,12 @@ func (s *headerBuilder) Build(header *http.Header) (*Value, error) {
 	if header == nil {
 		return nil, errors.New(errors.InvalidSettings, "header is nil")
 	}
+	if s.header.GetAll() {
+		if vs := header.Values(s.header.GetKey()); len(vs) > 0 {
+			return newStringList(vs), nil
+		}
+		return nil, errors.Newf(errors.NotFound, "%s is not in headers", s.header.GetKey())
+	}
 	if v := header.Get(s.header.GetKey()); v != "" {
 		return NewS(v), nil
 	}
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 func TestHeaderBuilder(t *testing.T) {
@@ -66,6 +68,35 @@ func TestHeaderBuilder(t *testing.T) {
 					},
 				},
 			},
+			{
+				title: "all not found",
+				vh: &pb.Value_Header{
+					Key: "Vary",
+					All: true,
+				},
+				h: &http.Header{
+					"Accept": []string{
+						"application/json",
+					},
+				},
+			},
+			{
+				title: "all",
+				vh: &pb.Value_Header{
+					Key: "Vary",
+					All: true,
+				},
+				h: &http.Header{
+					"Vary": []string{
+						"Accept",
+						"Origin",
+					},
+				},
+				want: pb.NewL([]*pb.Value{
+					pb.NewS("Accept"),
+					pb.NewS("Origin"),
+				}),
+			},
 		} {
 			t.Run(tc.title, func(t *testing.T) {
 				b := pb.NewHeaderBuilder(tc.vh)
@@ -75,7 +106,7 @@ func TestHeaderBuilder(t *testing.T) {
 					return
 				}
 				assert.Nil(t, err)
-				assert.Equal(t, tc.want.GetS(), got.GetS())
+				assert.Equal(t, "", cmp.Diff(tc.want, got, protocmp.Transform()))
 			})
 		}
 	})
@@ -0,0 +1,89 @@
+package pb
+
+import (
+	"encoding/json"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+)
+
+// JSONParseBuilder parses json string into Value.
+type JSONParseBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewJSONParseBuilder(
+	jsonParse *Value_JsonParse,
+	valueCaster ValueCaster,
+	valueConverter ValueConverter,
+	templateValueBuilder TemplateValueBuilder,
+) JSONParseBuilder {
+	return &jsonParseBuilder{
+		jsonParse:            jsonParse,
+		valueCaster:          valueCaster,
+		valueConverter:       valueConverter,
+		templateValueBuilder: templateValueBuilder,
+	}
+}
+
+type jsonParseBuilder struct {
+	jsonParse            *Value_JsonParse
+	valueCaster          ValueCaster
+	valueConverter       ValueConverter
+	templateValueBuilder TemplateValueBuilder
+}
+
+func (s *jsonParseBuilder) Build(r TemplateSource) (*Value, error) {
+	b, err := s.templateValueBuilder.Build(s.jsonParse.GetValue(), r)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build json parse %s", util.JSON(s.jsonParse.GetValue()))
+	}
+	x, err := s.valueCaster.String(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.TypeCast, "cannot build json parse %s", util.JSON(b))
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(x), &v); err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot parse json %s", x)
+	}
+	return s.valueConverter.Convert(v)
+}
+
+// JSONStringifyBuilder serializes Value into json string.
+type JSONStringifyBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewJSONStringifyBuilder(
+	jsonStringify *Value_JsonStringify,
+	valueInverter ValueInverter,
+	templateValueBuilder TemplateValueBuilder,
+) JSONStringifyBuilder {
+	return &jsonStringifyBuilder{
+		jsonStringify:        jsonStringify,
+		valueInverter:        valueInverter,
+		templateValueBuilder: templateValueBuilder,
+	}
+}
+
+type jsonStringifyBuilder struct {
+	jsonStringify        *Value_JsonStringify
+	valueInverter        ValueInverter
+	templateValueBuilder TemplateValueBuilder
+}
+
+func (s *jsonStringifyBuilder) Build(r TemplateSource) (*Value, error) {
+	b, err := s.templateValueBuilder.Build(s.jsonStringify.GetValue(), r)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build json stringify %s", util.JSON(s.jsonStringify.GetValue()))
+	}
+	v, err := s.valueInverter.Invert(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InvalidValue, "cannot build json stringify %s", util.JSON(b))
+	}
+	x, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.Jsonify, "cannot build json stringify %v", v)
+	}
+	return NewS(string(x)), nil
+}
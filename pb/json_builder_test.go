@@ -0,0 +1,114 @@
+package pb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONParseBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			value *pb.Value
+			err   error
+			want  interface{}
+			isErr bool
+		}{
+			{
+				title: "template error",
+				value: pb.NewS(`{}`),
+				err:   fmt.Errorf("template value build error"),
+				isErr: true,
+			},
+			{
+				title: "not string",
+				value: pb.NewL(nil),
+				isErr: true,
+			},
+			{
+				title: "invalid json",
+				value: pb.NewS(`{`),
+				isErr: true,
+			},
+			{
+				title: "object",
+				value: pb.NewS(`{"a":[1,"b",null]}`),
+				want: map[string]interface{}{
+					"a": []interface{}{1, "b", nil},
+				},
+			},
+			{
+				title: "number",
+				value: pb.NewS(`1.5`),
+				want:  1.5,
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewJSONParseBuilder(&pb.Value_JsonParse{
+					Value: tc.value,
+				}, pb.NewValueCaster(), pb.NewValueConverter(), &mockTemplateValueBuilder{
+					err: tc.err,
+				}).Build(nil)
+				if tc.isErr {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				v, err := pb.NewValueInverter().Invert(got)
+				assert.Nil(t, err)
+				assert.Equal(t, "", cmp.Diff(tc.want, v))
+			})
+		}
+	})
+}
+
+func TestJSONStringifyBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			value *pb.Value
+			err   error
+			want  string
+		}{
+			{
+				title: "template error",
+				value: pb.NewNull(),
+				err:   fmt.Errorf("template value build error"),
+			},
+			{
+				title: "map",
+				value: pb.NewM(map[string]*pb.Value{
+					"a": pb.NewL([]*pb.Value{
+						pb.NewN(1),
+						pb.NewS("b"),
+						pb.NewNull(),
+					}),
+				}),
+				want: `{"a":[1,"b",null]}`,
+			},
+			{
+				title: "string",
+				value: pb.NewS("x"),
+				want:  `"x"`,
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewJSONStringifyBuilder(&pb.Value_JsonStringify{
+					Value: tc.value,
+				}, pb.NewValueInverter(), &mockTemplateValueBuilder{
+					err: tc.err,
+				}).Build(nil)
+				if tc.err != nil {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, got.GetS())
+			})
+		}
+	})
+}
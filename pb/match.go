@@ -0,0 +1,103 @@
+package pb
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+)
+
+// RequestMatcher tests whether the request satisfies the conditions.
+type RequestMatcher interface {
+	// Match returns true if m is nil or the request satisfies all of m.
+	Match(m *Match, r *http.Request, body []byte) (bool, error)
+	// Score returns the number of the conditions of m satisfied by the request.
+	Score(m *Match, r *http.Request, body []byte) (int, error)
+}
+
+func NewRequestMatcher(valueInverter ValueInverter) RequestMatcher {
+	return &requestMatcher{
+		valueInverter: valueInverter,
+	}
+}
+
+type requestMatcher struct {
+	valueInverter ValueInverter
+}
+
+func (s *requestMatcher) Match(m *Match, r *http.Request, body []byte) (bool, error) {
+	score, total, err := s.score(m, r, body)
+	return err == nil && score == total, err
+}
+
+func (s *requestMatcher) Score(m *Match, r *http.Request, body []byte) (int, error) {
+	score, _, err := s.score(m, r, body)
+	return score, err
+}
+
+// score returns the number of the satisfied conditions and the number of the conditions.
+func (s *requestMatcher) score(m *Match, r *http.Request, body []byte) (int, int, error) {
+	var score, total int
+	for k, v := range m.GetHeaders() {
+		total++
+		if containsString(r.Header.Values(k), v) {
+			score++
+		}
+	}
+	if len(m.GetQueries()) > 0 {
+		q := r.URL.Query()
+		for k, v := range m.GetQueries() {
+			total++
+			if containsString(q[k], v) {
+				score++
+			}
+		}
+	}
+	if len(m.GetBody()) == 0 {
+		return score, total, nil
+	}
+	total += len(m.GetBody())
+	var b interface{}
+	if err := json.Unmarshal(body, &b); err != nil {
+		return score, total, nil
+	}
+	for k, v := range m.GetBody() {
+		want, err := s.normalize(v)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, errors.InvalidValue, "match body %s", k)
+		}
+		if got, ok := util.GetPath(b, strings.Split(k, ".")); ok && reflect.DeepEqual(want, got) {
+			score++
+		}
+	}
+	return score, total, nil
+}
+
+// normalize inverts the value into the form of json.Unmarshal.
+func (s *requestMatcher) normalize(value *Value) (interface{}, error) {
+	v, err := s.valueInverter.Invert(value)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var x interface{}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func containsString(xs []string, v string) bool {
+	for _, x := range xs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
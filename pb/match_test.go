@@ -0,0 +1,96 @@
+package pb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestRequestMatcher(t *testing.T) {
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/users?id=1&id=2&type=admin", nil)
+		r.Header.Set("Accept", "application/json")
+		return r
+	}
+	const body = `{"user":{"name":"alice","tags":["a","b"],"age":20}}`
+
+	for _, tc := range []*struct {
+		title string
+		match string
+		body  string
+		want  bool
+		score int
+	}{
+		{
+			title: "no conditions",
+			body:  body,
+			want:  true,
+		},
+		{
+			title: "all matched",
+			match: `{"headers":{"Accept":"application/json"},"queries":{"id":"2"},"body":{
+"user.name":{"s":"alice"},
+"user.age":{"n":20},
+"user.tags":{"l":{"values":[{"s":"a"},{"s":"b"}]}}
+}}`,
+			body:  body,
+			want:  true,
+			score: 5,
+		},
+		{
+			title: "header unmatched",
+			match: `{"headers":{"Accept":"text/plain"}}`,
+			body:  body,
+		},
+		{
+			title: "header missing",
+			match: `{"headers":{"Authorization":"token"}}`,
+			body:  body,
+		},
+		{
+			title: "query unmatched",
+			match: `{"queries":{"type":"guest"}}`,
+			body:  body,
+		},
+		{
+			title: "partially matched",
+			match: `{"queries":{"id":"1","type":"guest"}}`,
+			body:  body,
+			score: 1,
+		},
+		{
+			title: "body unmatched",
+			match: `{"body":{"user.age":{"n":21}}}`,
+			body:  body,
+		},
+		{
+			title: "body missing",
+			match: `{"body":{"user.email":{"s":"alice@example.com"}}}`,
+			body:  body,
+		},
+		{
+			title: "body not json",
+			match: `{"body":{"user.name":{"s":"alice"}}}`,
+			body:  "alice",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var m *pb.Match
+			if tc.match != "" {
+				m = &pb.Match{}
+				assert.Nil(t, protojson.Unmarshal([]byte(tc.match), m))
+			}
+			matcher := pb.NewRequestMatcher(pb.NewValueInverter())
+			got, err := matcher.Match(m, newRequest(), []byte(tc.body))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, got)
+			score, err := matcher.Score(m, newRequest(), []byte(tc.body))
+			assert.Nil(t, err)
+			assert.Equal(t, tc.score, score)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package pb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/openapi"
+)
+
+// OpenAPIBuilder builds handlers mocking the operations of an OpenAPI document.
+//
+// An operation is mocked by the handler returning the first 2xx response, default or the first response,
+// and the handlers returning the other responses if the request has Prefer: code=STATUS header.
+type OpenAPIBuilder interface {
+	Build(doc *openapi.Document) ([]*Handler, error)
+}
+
+func NewOpenAPIBuilder(valueConverter ValueConverter) OpenAPIBuilder {
+	return &openAPIBuilder{
+		valueConverter: valueConverter,
+	}
+}
+
+type openAPIBuilder struct {
+	valueConverter ValueConverter
+}
+
+func (s *openAPIBuilder) Build(doc *openapi.Document) ([]*Handler, error) {
+	var handlers []*Handler
+	for _, path := range doc.SortedPaths() {
+		ops := doc.Paths[path].Operations()
+		methods := make([]string, 0, len(ops))
+		for m := range ops {
+			methods = append(methods, m)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			hs, err := s.buildOperation(doc, path, method, ops[method])
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidValue, "openapi %s %s", method, path)
+			}
+			handlers = append(handlers, hs...)
+		}
+	}
+	return handlers, nil
+}
+
+func (s *openAPIBuilder) buildOperation(doc *openapi.Document, path, method string, op *openapi.Operation) ([]*Handler, error) {
+	primary, others := responseCodes(op.Responses)
+	if primary == "" {
+		return nil, errors.New(errors.InvalidValue, "no responses")
+	}
+	newHandler := func(code string) (*Handler, error) {
+		res, err := doc.Response(op.Responses[code])
+		if err != nil {
+			return nil, err
+		}
+		status := http.StatusOK
+		if x, err := strconv.Atoi(code); err == nil {
+			status = x
+		}
+		ret, err := s.buildReturn(doc, status, res)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidValue, "response %s", code)
+		}
+		return &Handler{
+			Path:       path,
+			MethodType: MethodType(MethodType_value[method]),
+			Action: &Action{
+				Action: &Action_Return_{
+					Return: ret,
+				},
+			},
+		}, nil
+	}
+	var handlers []*Handler
+	for _, code := range others {
+		h, err := newHandler(code)
+		if err != nil {
+			return nil, err
+		}
+		h.Match = &Match{
+			Headers: map[string]string{
+				"Prefer": "code=" + code,
+			},
+		}
+		handlers = append(handlers, h)
+	}
+	h, err := newHandler(primary)
+	if err != nil {
+		return nil, err
+	}
+	return append(handlers, h), nil
+}
+
+// responseCodes returns the code of the primary response and the other status codes in order.
+func responseCodes(responses map[string]*openapi.Response) (string, []string) {
+	var codes []string
+	for k := range responses {
+		if _, err := strconv.Atoi(k); err == nil {
+			codes = append(codes, k)
+		}
+	}
+	sort.Strings(codes)
+	primary := ""
+	for _, k := range codes {
+		if strings.HasPrefix(k, "2") {
+			primary = k
+			break
+		}
+	}
+	if primary == "" {
+		if _, ok := responses["default"]; ok {
+			primary = "default"
+		} else if len(codes) > 0 {
+			primary = codes[0]
+		}
+	}
+	others := make([]string, 0, len(codes))
+	for _, k := range codes {
+		if k != primary {
+			others = append(others, k)
+		}
+	}
+	return primary, others
+}
+
+func (s *openAPIBuilder) buildReturn(doc *openapi.Document, status int, res *openapi.Response) (*Action_Return, error) {
+	ret := &Action_Return{
+		Status: int32(status),
+	}
+	headers := map[string]*Value{}
+	names := make([]string, 0, len(res.Headers))
+	for k := range res.Headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		h := res.Headers[k]
+		v := h.Example
+		if v == nil {
+			x, err := doc.SchemaExample(h.Schema)
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidValue, "header %s", k)
+			}
+			v = x
+		}
+		if v != nil {
+			headers[http.CanonicalHeaderKey(k)] = NewS(fmt.Sprint(v))
+		}
+	}
+	contentType, media := selectMediaType(res.Content)
+	var body interface{}
+	if media != nil {
+		headers["Content-Type"] = NewS(contentType)
+		x, err := doc.MediaExample(media)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidValue, "content %s", contentType)
+		}
+		body = x
+	}
+	if len(headers) > 0 {
+		ret.Templates = append(ret.Templates, &Template{
+			Type:  Template_HEADER,
+			Value: NewM(headers),
+		})
+	}
+	switch b := body.(type) {
+	case map[string]interface{}:
+		v, err := s.valueConverter.Convert(b)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InvalidValue, "convert example")
+		}
+		ret.Templates = append(ret.Templates, &Template{
+			Type:  Template_BODY,
+			Value: v,
+		})
+	case string:
+		if strings.Contains(contentType, "json") {
+			x, _ := json.Marshal(b)
+			ret.Raw = string(x)
+		} else {
+			ret.Raw = b
+		}
+	case nil:
+		if media != nil && strings.Contains(contentType, "json") {
+			ret.Raw = "null"
+			break
+		}
+		// empty object not to echo the request
+		ret.Templates = append(ret.Templates, &Template{
+			Type:  Template_BODY,
+			Value: NewM(map[string]*Value{}),
+		})
+	default:
+		x, err := json.Marshal(b)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InvalidValue, "marshal example")
+		}
+		ret.Raw = string(x)
+	}
+	return ret, nil
+}
+
+// selectMediaType returns application/json, other json or the first media type.
+func selectMediaType(content map[string]*openapi.MediaType) (string, *openapi.MediaType) {
+	if x, ok := content["application/json"]; ok {
+		return "application/json", x
+	}
+	keys := make([]string, 0, len(content))
+	for k := range content {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if strings.Contains(k, "json") {
+			return k, content[k]
+		}
+	}
+	if len(keys) > 0 {
+		return keys[0], content[keys[0]]
+	}
+	return "", nil
+}
@@ -0,0 +1,85 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/internal/openapi"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestOpenAPIBuilder(t *testing.T) {
+	for _, tc := range []*struct {
+		title string
+		doc   string
+		want  string
+		isErr bool
+	}{
+		{
+			title: "statuses",
+			doc: `{"openapi":"3.0.0","paths":{"/users/{id}":{"get":{"responses":{
+"404":{"description":"not found","content":{"application/json":{"example":{"message":"not found"}}}},
+"200":{"description":"user","headers":{"X-Rate-Limit":{"schema":{"type":"integer","minimum":10}}},
+  "content":{"application/json":{"schema":{"type":"object","properties":{"id":{"type":"integer"}}}}}}
+}}}}}`,
+			want: `{"handlers":[
+{"path":"/users/{id}","match":{"headers":{"Prefer":"code=404"}},"action":{"return":{"status":404,"templates":[
+  {"type":"HEADER","value":{"m":{"values":{"Content-Type":{"s":"application/json"}}}}},
+  {"value":{"m":{"values":{"message":{"s":"not found"}}}}}
+]}}},
+{"path":"/users/{id}","action":{"return":{"status":200,"templates":[
+  {"type":"HEADER","value":{"m":{"values":{"Content-Type":{"s":"application/json"},"X-Rate-Limit":{"s":"10"}}}}},
+  {"value":{"m":{"values":{"id":{"n":0}}}}}
+]}}}
+]}`,
+		},
+		{
+			title: "methods and raw bodies",
+			doc: `{"openapi":"3.0.0","paths":{
+"/users":{
+  "post":{"responses":{"default":{"description":"created","content":{"application/json":{"schema":{"type":"array","items":{"type":"string"}}}}}}},
+  "delete":{"responses":{"204":{"description":"deleted"}}}
+},
+"/hello":{"get":{"responses":{"200":{"description":"text","content":{"text/plain":{"example":"hello"}}}}}}
+}}`,
+			want: `{"handlers":[
+{"path":"/hello","action":{"return":{"status":200,"raw":"hello","templates":[
+  {"type":"HEADER","value":{"m":{"values":{"Content-Type":{"s":"text/plain"}}}}}
+]}}},
+{"path":"/users","methodType":"DELETE","action":{"return":{"status":204,"templates":[
+  {"value":{"m":{}}}
+]}}},
+{"path":"/users","methodType":"POST","action":{"return":{"status":200,"raw":"[\"string\"]","templates":[
+  {"type":"HEADER","value":{"m":{"values":{"Content-Type":{"s":"application/json"}}}}}
+]}}}
+]}`,
+		},
+		{
+			title: "no responses",
+			doc:   `{"openapi":"3.0.0","paths":{"/hello":{"get":{"responses":{}}}}}`,
+			isErr: true,
+		},
+		{
+			title: "unknown ref",
+			doc:   `{"openapi":"3.0.0","paths":{"/hello":{"get":{"responses":{"200":{"$ref":"#/components/responses/OK"}}}}}}`,
+			isErr: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			doc, err := openapi.Parse([]byte(tc.doc))
+			assert.Nil(t, err)
+			got, err := pb.NewOpenAPIBuilder(pb.NewValueConverter()).Build(doc)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			var want pb.Server
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.want), &want))
+			assert.Equal(t, "", cmp.Diff(want.GetHandlers(), got, protocmp.Transform()))
+		})
+	}
+}
@@ -24,8 +24,16 @@ const (
 type MethodType int32
 
 const (
-	MethodType_GET  MethodType = 0
-	MethodType_POST MethodType = 1
+	MethodType_GET     MethodType = 0
+	MethodType_POST    MethodType = 1
+	MethodType_PUT     MethodType = 2
+	MethodType_DELETE  MethodType = 3
+	MethodType_PATCH   MethodType = 4
+	MethodType_HEAD    MethodType = 5
+	MethodType_OPTIONS MethodType = 6
+	// Any method.
+	// Gateway forwards the incoming method.
+	MethodType_ANY MethodType = 7
 )
 
 // Enum value maps for MethodType.
@@ -33,10 +41,22 @@ var (
 	MethodType_name = map[int32]string{
 		0: "GET",
 		1: "POST",
+		2: "PUT",
+		3: "DELETE",
+		4: "PATCH",
+		5: "HEAD",
+		6: "OPTIONS",
+		7: "ANY",
 	}
 	MethodType_value = map[string]int32{
-		"GET":  0,
-		"POST": 1,
+		"GET":     0,
+		"POST":    1,
+		"PUT":     2,
+		"DELETE":  3,
+		"PATCH":   4,
+		"HEAD":    5,
+		"OPTIONS": 6,
+		"ANY":     7,
 	}
 )
 
@@ -132,6 +152,124 @@ func (Value_Url_Part) EnumDescriptor() ([]byte, []int) {
 	return file_origin_proto_rawDescGZIP(), []int{0, 2, 0}
 }
 
+type Value_Request_Part int32
+
+const (
+	Value_Request_METHOD Value_Request_Part = 0
+	// host:port of the client.
+	Value_Request_REMOTE_ADDR Value_Request_Part = 1
+	// host of the client.
+	Value_Request_REMOTE_HOST Value_Request_Part = 2
+	// e.g. HTTP/1.1
+	Value_Request_PROTO          Value_Request_Part = 3
+	Value_Request_CONTENT_LENGTH Value_Request_Part = 4
+	// X-Request-Id of the response.
+	Value_Request_REQUEST_ID Value_Request_Part = 5
+)
+
+// Enum value maps for Value_Request_Part.
+var (
+	Value_Request_Part_name = map[int32]string{
+		0: "METHOD",
+		1: "REMOTE_ADDR",
+		2: "REMOTE_HOST",
+		3: "PROTO",
+		4: "CONTENT_LENGTH",
+		5: "REQUEST_ID",
+	}
+	Value_Request_Part_value = map[string]int32{
+		"METHOD":         0,
+		"REMOTE_ADDR":    1,
+		"REMOTE_HOST":    2,
+		"PROTO":          3,
+		"CONTENT_LENGTH": 4,
+		"REQUEST_ID":     5,
+	}
+)
+
+func (x Value_Request_Part) Enum() *Value_Request_Part {
+	p := new(Value_Request_Part)
+	*p = x
+	return p
+}
+
+func (x Value_Request_Part) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value_Request_Part) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[2].Descriptor()
+}
+
+func (Value_Request_Part) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[2]
+}
+
+func (x Value_Request_Part) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value_Request_Part.Descriptor instead.
+func (Value_Request_Part) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 3, 0}
+}
+
+type Value_Dump_Target int32
+
+const (
+	// Request body as json.
+	Value_Dump_BODY Value_Dump_Target = 0
+	// Map of request headers.
+	Value_Dump_HEADER Value_Dump_Target = 1
+	// Map of request query.
+	Value_Dump_QUERY Value_Dump_Target = 2
+	// Map of request cookies.
+	Value_Dump_COOKIE Value_Dump_Target = 3
+)
+
+// Enum value maps for Value_Dump_Target.
+var (
+	Value_Dump_Target_name = map[int32]string{
+		0: "BODY",
+		1: "HEADER",
+		2: "QUERY",
+		3: "COOKIE",
+	}
+	Value_Dump_Target_value = map[string]int32{
+		"BODY":   0,
+		"HEADER": 1,
+		"QUERY":  2,
+		"COOKIE": 3,
+	}
+)
+
+func (x Value_Dump_Target) Enum() *Value_Dump_Target {
+	p := new(Value_Dump_Target)
+	*p = x
+	return p
+}
+
+func (x Value_Dump_Target) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value_Dump_Target) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[3].Descriptor()
+}
+
+func (Value_Dump_Target) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[3]
+}
+
+func (x Value_Dump_Target) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value_Dump_Target.Descriptor instead.
+func (Value_Dump_Target) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 5, 0}
+}
+
 type Value_Util_Now_Type int32
 
 const (
@@ -159,11 +297,11 @@ func (x Value_Util_Now_Type) String() string {
 }
 
 func (Value_Util_Now_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_origin_proto_enumTypes[2].Descriptor()
+	return file_origin_proto_enumTypes[4].Descriptor()
 }
 
 func (Value_Util_Now_Type) Type() protoreflect.EnumType {
-	return &file_origin_proto_enumTypes[2]
+	return &file_origin_proto_enumTypes[4]
 }
 
 func (x Value_Util_Now_Type) Number() protoreflect.EnumNumber {
@@ -172,7 +310,7 @@ func (x Value_Util_Now_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Value_Util_Now_Type.Descriptor instead.
 func (Value_Util_Now_Type) EnumDescriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 3, 0, 0}
+	return file_origin_proto_rawDescGZIP(), []int{0, 6, 0, 0}
 }
 
 type Value_Util_Random_Type int32
@@ -206,11 +344,11 @@ func (x Value_Util_Random_Type) String() string {
 }
 
 func (Value_Util_Random_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_origin_proto_enumTypes[3].Descriptor()
+	return file_origin_proto_enumTypes[5].Descriptor()
 }
 
 func (Value_Util_Random_Type) Type() protoreflect.EnumType {
-	return &file_origin_proto_enumTypes[3]
+	return &file_origin_proto_enumTypes[5]
 }
 
 func (x Value_Util_Random_Type) Number() protoreflect.EnumNumber {
@@ -219,7 +357,7 @@ func (x Value_Util_Random_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Value_Util_Random_Type.Descriptor instead.
 func (Value_Util_Random_Type) EnumDescriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 3, 1, 0}
+	return file_origin_proto_rawDescGZIP(), []int{0, 6, 1, 0}
 }
 
 type Value_Add_Type int32
@@ -254,11 +392,11 @@ func (x Value_Add_Type) String() string {
 }
 
 func (Value_Add_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_origin_proto_enumTypes[4].Descriptor()
+	return file_origin_proto_enumTypes[6].Descriptor()
 }
 
 func (Value_Add_Type) Type() protoreflect.EnumType {
-	return &file_origin_proto_enumTypes[4]
+	return &file_origin_proto_enumTypes[6]
 }
 
 func (x Value_Add_Type) Number() protoreflect.EnumNumber {
@@ -267,7 +405,7 @@ func (x Value_Add_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Value_Add_Type.Descriptor instead.
 func (Value_Add_Type) EnumDescriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 4, 0}
+	return file_origin_proto_rawDescGZIP(), []int{0, 7, 0}
 }
 
 type Value_Cast_Type int32
@@ -303,11 +441,11 @@ func (x Value_Cast_Type) String() string {
 }
 
 func (Value_Cast_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_origin_proto_enumTypes[5].Descriptor()
+	return file_origin_proto_enumTypes[7].Descriptor()
 }
 
 func (Value_Cast_Type) Type() protoreflect.EnumType {
-	return &file_origin_proto_enumTypes[5]
+	return &file_origin_proto_enumTypes[7]
 }
 
 func (x Value_Cast_Type) Number() protoreflect.EnumNumber {
@@ -316,7 +454,300 @@ func (x Value_Cast_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Value_Cast_Type.Descriptor instead.
 func (Value_Cast_Type) EnumDescriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 5, 0}
+	return file_origin_proto_rawDescGZIP(), []int{0, 8, 0}
+}
+
+type Value_Encode_Type int32
+
+const (
+	Value_Encode_HEX Value_Encode_Type = 0
+	// Standard base64 with padding.
+	Value_Encode_BASE64 Value_Encode_Type = 1
+	// URL safe base64 without padding.
+	// Decode accepts the padded form too.
+	Value_Encode_BASE64_URL Value_Encode_Type = 2
+	// Query escape.
+	Value_Encode_URL Value_Encode_Type = 3
+)
+
+// Enum value maps for Value_Encode_Type.
+var (
+	Value_Encode_Type_name = map[int32]string{
+		0: "HEX",
+		1: "BASE64",
+		2: "BASE64_URL",
+		3: "URL",
+	}
+	Value_Encode_Type_value = map[string]int32{
+		"HEX":        0,
+		"BASE64":     1,
+		"BASE64_URL": 2,
+		"URL":        3,
+	}
+)
+
+func (x Value_Encode_Type) Enum() *Value_Encode_Type {
+	p := new(Value_Encode_Type)
+	*p = x
+	return p
+}
+
+func (x Value_Encode_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value_Encode_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[8].Descriptor()
+}
+
+func (Value_Encode_Type) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[8]
+}
+
+func (x Value_Encode_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value_Encode_Type.Descriptor instead.
+func (Value_Encode_Type) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 11, 0}
+}
+
+type Value_Hash_Type int32
+
+const (
+	Value_Hash_MD5    Value_Hash_Type = 0
+	Value_Hash_SHA1   Value_Hash_Type = 1
+	Value_Hash_SHA256 Value_Hash_Type = 2
+	Value_Hash_SHA512 Value_Hash_Type = 3
+)
+
+// Enum value maps for Value_Hash_Type.
+var (
+	Value_Hash_Type_name = map[int32]string{
+		0: "MD5",
+		1: "SHA1",
+		2: "SHA256",
+		3: "SHA512",
+	}
+	Value_Hash_Type_value = map[string]int32{
+		"MD5":    0,
+		"SHA1":   1,
+		"SHA256": 2,
+		"SHA512": 3,
+	}
+)
+
+func (x Value_Hash_Type) Enum() *Value_Hash_Type {
+	p := new(Value_Hash_Type)
+	*p = x
+	return p
+}
+
+func (x Value_Hash_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value_Hash_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[9].Descriptor()
+}
+
+func (Value_Hash_Type) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[9]
+}
+
+func (x Value_Hash_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value_Hash_Type.Descriptor instead.
+func (Value_Hash_Type) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 13, 0}
+}
+
+type Value_Collection_Type int32
+
+const (
+	// Number of elements of List or Map, or length of string.
+	Value_Collection_LEN Value_Collection_Type = 0
+	// Element at args[0].
+	// args[0] is index of List, negative index counts from the end, or key of Map.
+	Value_Collection_GET Value_Collection_Type = 1
+	// Part of List from args[0] to args[1], args[1] is exclusive and optional.
+	Value_Collection_SLICE Value_Collection_Type = 2
+	// Elements of List or Map that item evaluates to true.
+	Value_Collection_FILTER Value_Collection_Type = 3
+	// List or Map of item evaluated for each element.
+	Value_Collection_MAP Value_Collection_Type = 4
+	// List sorted by item if given, otherwise by the elements.
+	Value_Collection_SORT Value_Collection_Type = 5
+	// List without duplicates.
+	Value_Collection_UNIQUE Value_Collection_Type = 6
+	// Map merged value and args in order like APPEND.
+	Value_Collection_MERGE Value_Collection_Type = 7
+	// Map with only the keys in args.
+	Value_Collection_PICK Value_Collection_Type = 8
+	// Map without the keys in args.
+	Value_Collection_OMIT Value_Collection_Type = 9
+	// Sorted keys of Map.
+	Value_Collection_KEYS Value_Collection_Type = 10
+	// Values of Map sorted by key.
+	Value_Collection_VALUES Value_Collection_Type = 11
+)
+
+// Enum value maps for Value_Collection_Type.
+var (
+	Value_Collection_Type_name = map[int32]string{
+		0:  "LEN",
+		1:  "GET",
+		2:  "SLICE",
+		3:  "FILTER",
+		4:  "MAP",
+		5:  "SORT",
+		6:  "UNIQUE",
+		7:  "MERGE",
+		8:  "PICK",
+		9:  "OMIT",
+		10: "KEYS",
+		11: "VALUES",
+	}
+	Value_Collection_Type_value = map[string]int32{
+		"LEN":    0,
+		"GET":    1,
+		"SLICE":  2,
+		"FILTER": 3,
+		"MAP":    4,
+		"SORT":   5,
+		"UNIQUE": 6,
+		"MERGE":  7,
+		"PICK":   8,
+		"OMIT":   9,
+		"KEYS":   10,
+		"VALUES": 11,
+	}
+)
+
+func (x Value_Collection_Type) Enum() *Value_Collection_Type {
+	p := new(Value_Collection_Type)
+	*p = x
+	return p
+}
+
+func (x Value_Collection_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value_Collection_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[10].Descriptor()
+}
+
+func (Value_Collection_Type) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[10]
+}
+
+func (x Value_Collection_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value_Collection_Type.Descriptor instead.
+func (Value_Collection_Type) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 14, 0}
+}
+
+type Value_Item_Part int32
+
+const (
+	Value_Item_VALUE Value_Item_Part = 0
+	// Index of List or key of Map.
+	Value_Item_KEY Value_Item_Part = 1
+)
+
+// Enum value maps for Value_Item_Part.
+var (
+	Value_Item_Part_name = map[int32]string{
+		0: "VALUE",
+		1: "KEY",
+	}
+	Value_Item_Part_value = map[string]int32{
+		"VALUE": 0,
+		"KEY":   1,
+	}
+)
+
+func (x Value_Item_Part) Enum() *Value_Item_Part {
+	p := new(Value_Item_Part)
+	*p = x
+	return p
+}
+
+func (x Value_Item_Part) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value_Item_Part) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[11].Descriptor()
+}
+
+func (Value_Item_Part) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[11]
+}
+
+func (x Value_Item_Part) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value_Item_Part.Descriptor instead.
+func (Value_Item_Part) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 15, 0}
+}
+
+type Value_Upstream_Part int32
+
+const (
+	Value_Upstream_BODY   Value_Upstream_Part = 0
+	Value_Upstream_STATUS Value_Upstream_Part = 1
+	Value_Upstream_HEADER Value_Upstream_Part = 2
+)
+
+// Enum value maps for Value_Upstream_Part.
+var (
+	Value_Upstream_Part_name = map[int32]string{
+		0: "BODY",
+		1: "STATUS",
+		2: "HEADER",
+	}
+	Value_Upstream_Part_value = map[string]int32{
+		"BODY":   0,
+		"STATUS": 1,
+		"HEADER": 2,
+	}
+)
+
+func (x Value_Upstream_Part) Enum() *Value_Upstream_Part {
+	p := new(Value_Upstream_Part)
+	*p = x
+	return p
+}
+
+func (x Value_Upstream_Part) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value_Upstream_Part) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[12].Descriptor()
+}
+
+func (Value_Upstream_Part) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[12]
+}
+
+func (x Value_Upstream_Part) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value_Upstream_Part.Descriptor instead.
+func (Value_Upstream_Part) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 18, 0}
 }
 
 type Template_Type int32
@@ -325,7 +756,23 @@ const (
 	// Into body.
 	Template_BODY Template_Type = 0
 	// Into headers.
+	// The value of a header may be List to set multiple values.
 	Template_HEADER Template_Type = 1
+	// Set value at keys of body, e.g. ["items", "0", "name"].
+	// Missing maps are created, index of the length of a list appends.
+	Template_SET Template_Type = 2
+	// Delete keys of body.
+	// Edits apply after the other templates, use APPEND to edit the original.
+	Template_DELETE Template_Type = 3
+	// Move keys of body to to.
+	Template_RENAME Template_Type = 4
+	// Delete headers named by keys.
+	Template_DELETE_HEADER Template_Type = 5
+	// Add Set-Cookie headers.
+	// The value is a Map of a cookie or a List of them,
+	// the keys of a cookie are name, value, path, domain, expires (unix seconds or http date),
+	// maxAge, httpOnly, secure and sameSite (Lax, Strict or None).
+	Template_COOKIE Template_Type = 6
 )
 
 // Enum value maps for Template_Type.
@@ -333,10 +780,20 @@ var (
 	Template_Type_name = map[int32]string{
 		0: "BODY",
 		1: "HEADER",
+		2: "SET",
+		3: "DELETE",
+		4: "RENAME",
+		5: "DELETE_HEADER",
+		6: "COOKIE",
 	}
 	Template_Type_value = map[string]int32{
-		"BODY":   0,
-		"HEADER": 1,
+		"BODY":          0,
+		"HEADER":        1,
+		"SET":           2,
+		"DELETE":        3,
+		"RENAME":        4,
+		"DELETE_HEADER": 5,
+		"COOKIE":        6,
 	}
 )
 
@@ -351,11 +808,11 @@ func (x Template_Type) String() string {
 }
 
 func (Template_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_origin_proto_enumTypes[6].Descriptor()
+	return file_origin_proto_enumTypes[13].Descriptor()
 }
 
 func (Template_Type) Type() protoreflect.EnumType {
-	return &file_origin_proto_enumTypes[6]
+	return &file_origin_proto_enumTypes[13]
 }
 
 func (x Template_Type) Number() protoreflect.EnumNumber {
@@ -399,11 +856,11 @@ func (x Action_TemplateType) String() string {
 }
 
 func (Action_TemplateType) Descriptor() protoreflect.EnumDescriptor {
-	return file_origin_proto_enumTypes[7].Descriptor()
+	return file_origin_proto_enumTypes[14].Descriptor()
 }
 
 func (Action_TemplateType) Type() protoreflect.EnumType {
-	return &file_origin_proto_enumTypes[7]
+	return &file_origin_proto_enumTypes[14]
 }
 
 func (x Action_TemplateType) Number() protoreflect.EnumNumber {
@@ -415,6 +872,60 @@ func (Action_TemplateType) EnumDescriptor() ([]byte, []int) {
 	return file_origin_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type Upstreams_Strategy int32
+
+const (
+	Upstreams_ROUND_ROBIN Upstreams_Strategy = 0
+	Upstreams_RANDOM      Upstreams_Strategy = 1
+	// Random by weight.
+	Upstreams_WEIGHTED Upstreams_Strategy = 2
+	// Target with the fewest requests in flight.
+	Upstreams_LEAST_OUTSTANDING Upstreams_Strategy = 3
+)
+
+// Enum value maps for Upstreams_Strategy.
+var (
+	Upstreams_Strategy_name = map[int32]string{
+		0: "ROUND_ROBIN",
+		1: "RANDOM",
+		2: "WEIGHTED",
+		3: "LEAST_OUTSTANDING",
+	}
+	Upstreams_Strategy_value = map[string]int32{
+		"ROUND_ROBIN":       0,
+		"RANDOM":            1,
+		"WEIGHTED":          2,
+		"LEAST_OUTSTANDING": 3,
+	}
+)
+
+func (x Upstreams_Strategy) Enum() *Upstreams_Strategy {
+	p := new(Upstreams_Strategy)
+	*p = x
+	return p
+}
+
+func (x Upstreams_Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Upstreams_Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_origin_proto_enumTypes[15].Descriptor()
+}
+
+func (Upstreams_Strategy) Type() protoreflect.EnumType {
+	return &file_origin_proto_enumTypes[15]
+}
+
+func (x Upstreams_Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Upstreams_Strategy.Descriptor instead.
+func (Upstreams_Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type Value struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -433,6 +944,20 @@ type Value struct {
 	//	*Value_Util_
 	//	*Value_Add_
 	//	*Value_Cast_
+	//	*Value_Format
+	//	*Value_Expr
+	//	*Value_Request_
+	//	*Value_Cookie_
+	//	*Value_Dump_
+	//	*Value_JsonParse_
+	//	*Value_JsonStringify_
+	//	*Value_Encode_
+	//	*Value_Decode_
+	//	*Value_Hash_
+	//	*Value_Collection_
+	//	*Value_Item_
+	//	*Value_Upstream_
+	//	*Value_Param_
 	Value isValue_Value `protobuf_oneof:"value"`
 }
 
@@ -559,27 +1084,125 @@ func (x *Value) GetCast() *Value_Cast {
 	return nil
 }
 
-type isValue_Value interface {
-	isValue_Value()
+func (x *Value) GetFormat() string {
+	if x, ok := x.GetValue().(*Value_Format); ok {
+		return x.Format
+	}
+	return ""
 }
 
-type Value_Null struct {
-	Null structpb.NullValue `protobuf:"varint,100,opt,name=null,proto3,enum=google.protobuf.NullValue,oneof"`
+func (x *Value) GetExpr() string {
+	if x, ok := x.GetValue().(*Value_Expr); ok {
+		return x.Expr
+	}
+	return ""
 }
 
-type Value_B struct {
-	B bool `protobuf:"varint,101,opt,name=b,proto3,oneof"`
+func (x *Value) GetRequest() *Value_Request {
+	if x, ok := x.GetValue().(*Value_Request_); ok {
+		return x.Request
+	}
+	return nil
 }
 
-type Value_N struct {
-	N float64 `protobuf:"fixed64,102,opt,name=n,proto3,oneof"`
+func (x *Value) GetCookie() *Value_Cookie {
+	if x, ok := x.GetValue().(*Value_Cookie_); ok {
+		return x.Cookie
+	}
+	return nil
 }
 
-type Value_S struct {
-	S string `protobuf:"bytes,103,opt,name=s,proto3,oneof"`
+func (x *Value) GetDump() *Value_Dump {
+	if x, ok := x.GetValue().(*Value_Dump_); ok {
+		return x.Dump
+	}
+	return nil
 }
 
-type Value_L struct {
+func (x *Value) GetJsonParse() *Value_JsonParse {
+	if x, ok := x.GetValue().(*Value_JsonParse_); ok {
+		return x.JsonParse
+	}
+	return nil
+}
+
+func (x *Value) GetJsonStringify() *Value_JsonStringify {
+	if x, ok := x.GetValue().(*Value_JsonStringify_); ok {
+		return x.JsonStringify
+	}
+	return nil
+}
+
+func (x *Value) GetEncode() *Value_Encode {
+	if x, ok := x.GetValue().(*Value_Encode_); ok {
+		return x.Encode
+	}
+	return nil
+}
+
+func (x *Value) GetDecode() *Value_Decode {
+	if x, ok := x.GetValue().(*Value_Decode_); ok {
+		return x.Decode
+	}
+	return nil
+}
+
+func (x *Value) GetHash() *Value_Hash {
+	if x, ok := x.GetValue().(*Value_Hash_); ok {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Value) GetCollection() *Value_Collection {
+	if x, ok := x.GetValue().(*Value_Collection_); ok {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *Value) GetItem() *Value_Item {
+	if x, ok := x.GetValue().(*Value_Item_); ok {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Value) GetUpstream() *Value_Upstream {
+	if x, ok := x.GetValue().(*Value_Upstream_); ok {
+		return x.Upstream
+	}
+	return nil
+}
+
+func (x *Value) GetParam() *Value_Param {
+	if x, ok := x.GetValue().(*Value_Param_); ok {
+		return x.Param
+	}
+	return nil
+}
+
+type isValue_Value interface {
+	isValue_Value()
+}
+
+type Value_Null struct {
+	Null structpb.NullValue `protobuf:"varint,100,opt,name=null,proto3,enum=google.protobuf.NullValue,oneof"`
+}
+
+type Value_B struct {
+	B bool `protobuf:"varint,101,opt,name=b,proto3,oneof"`
+}
+
+type Value_N struct {
+	N float64 `protobuf:"fixed64,102,opt,name=n,proto3,oneof"`
+}
+
+type Value_S struct {
+	S string `protobuf:"bytes,103,opt,name=s,proto3,oneof"`
+}
+
+type Value_L struct {
 	L *Value_List `protobuf:"bytes,104,opt,name=l,proto3,oneof"`
 }
 
@@ -611,6 +1234,113 @@ type Value_Cast_ struct {
 	Cast *Value_Cast `protobuf:"bytes,111,opt,name=cast,proto3,oneof"`
 }
 
+type Value_Format struct {
+	// String interpolation.
+	//
+	// Placeholders are written as ${SOURCE} and resolved into string.
+	//
+	//     ${body.KEY1.KEY2}  like Body, keys are separated by .
+	//     ${header.NAME}     like Header
+	//     ${query.KEY}       like Url.Query
+	//     ${path.INDEX}      like Url.Path
+	//     ${url.PART}        like Url.Part, PART is lower case, e.g. ${url.host}
+	//     ${request.PART}    like Request, PART is lower case, e.g. ${request.method}
+	//     ${cookie.NAME}     like Cookie
+	//     ${item.KEY1.KEY2}  like Item, keys are optional
+	//     ${upstream.NAME.KEY1.KEY2}  like Upstream BODY, keys are optional
+	//     ${param.NAME}      like Param
+	//
+	// $$ means $.
+	//
+	// # Example
+	//
+	//     "http://127.0.0.1:${body.port}/hello?id=${query.id}"
+	Format string `protobuf:"bytes,112,opt,name=format,proto3,oneof"`
+}
+
+type Value_Expr struct {
+	// Expression.
+	//
+	// Available names:
+	//
+	//     header         map of request headers by canonical name, e.g. Content-Type,
+	//                    the first value of each header
+	//     query          map of request query, the first value of each key
+	//     body           request body as json, null if not json
+	//     path           request path
+	//     now            current unix timestamp
+	//     method         request method
+	//     remoteAddr     host:port of the client
+	//     proto          request protocol
+	//     contentLength  request content length
+	//     requestId      X-Request-Id of the response
+	//     cookie         map of request cookies
+	//     item           the element in Collection item
+	//     key            index or key of the element in Collection item
+	//     upstream       map of the responses of Aggregate calls by name,
+	//                    a response is a map of status, header and body
+	//     param          map of the path parameters
+	//
+	// Operators are + - * / % == != < <= > >= && || ! ?: and
+	// member access by . or [], e.g. body.items[0], header["Content-Type"].
+	// Functions are len, upper, lower, trim, contains, startsWith, endsWith,
+	// replace, split, join, substr, keys, default, string, number, bool, int,
+	// abs, floor, ceil, round, min and max.
+	//
+	// # Example
+	//
+	//     "len(body.items) > 0 ? body.items[0].id : default(query.id, 0)"
+	Expr string `protobuf:"bytes,113,opt,name=expr,proto3,oneof"`
+}
+
+type Value_Request_ struct {
+	Request *Value_Request `protobuf:"bytes,114,opt,name=request,proto3,oneof"`
+}
+
+type Value_Cookie_ struct {
+	Cookie *Value_Cookie `protobuf:"bytes,115,opt,name=cookie,proto3,oneof"`
+}
+
+type Value_Dump_ struct {
+	Dump *Value_Dump `protobuf:"bytes,116,opt,name=dump,proto3,oneof"`
+}
+
+type Value_JsonParse_ struct {
+	JsonParse *Value_JsonParse `protobuf:"bytes,117,opt,name=jsonParse,proto3,oneof"`
+}
+
+type Value_JsonStringify_ struct {
+	JsonStringify *Value_JsonStringify `protobuf:"bytes,118,opt,name=jsonStringify,proto3,oneof"`
+}
+
+type Value_Encode_ struct {
+	Encode *Value_Encode `protobuf:"bytes,119,opt,name=encode,proto3,oneof"`
+}
+
+type Value_Decode_ struct {
+	Decode *Value_Decode `protobuf:"bytes,120,opt,name=decode,proto3,oneof"`
+}
+
+type Value_Hash_ struct {
+	Hash *Value_Hash `protobuf:"bytes,121,opt,name=hash,proto3,oneof"`
+}
+
+type Value_Collection_ struct {
+	Collection *Value_Collection `protobuf:"bytes,122,opt,name=collection,proto3,oneof"`
+}
+
+type Value_Item_ struct {
+	Item *Value_Item `protobuf:"bytes,123,opt,name=item,proto3,oneof"`
+}
+
+type Value_Upstream_ struct {
+	Upstream *Value_Upstream `protobuf:"bytes,124,opt,name=upstream,proto3,oneof"`
+}
+
+type Value_Param_ struct {
+	Param *Value_Param `protobuf:"bytes,125,opt,name=param,proto3,oneof"`
+}
+
 func (*Value_Null) isValue_Value() {}
 
 func (*Value_B) isValue_Value() {}
@@ -635,6 +1365,34 @@ func (*Value_Add_) isValue_Value() {}
 
 func (*Value_Cast_) isValue_Value() {}
 
+func (*Value_Format) isValue_Value() {}
+
+func (*Value_Expr) isValue_Value() {}
+
+func (*Value_Request_) isValue_Value() {}
+
+func (*Value_Cookie_) isValue_Value() {}
+
+func (*Value_Dump_) isValue_Value() {}
+
+func (*Value_JsonParse_) isValue_Value() {}
+
+func (*Value_JsonStringify_) isValue_Value() {}
+
+func (*Value_Encode_) isValue_Value() {}
+
+func (*Value_Decode_) isValue_Value() {}
+
+func (*Value_Hash_) isValue_Value() {}
+
+func (*Value_Collection_) isValue_Value() {}
+
+func (*Value_Item_) isValue_Value() {}
+
+func (*Value_Upstream_) isValue_Value() {}
+
+func (*Value_Param_) isValue_Value() {}
+
 // Request/Response data to Request/Response data mapper.
 type Template struct {
 	state         protoimpl.MessageState
@@ -643,6 +1401,11 @@ type Template struct {
 
 	Type  Template_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Template_Type" json:"type,omitempty"`
 	Value *Value        `protobuf:"bytes,102,opt,name=value,proto3" json:"value,omitempty"`
+	// Path for SET, DELETE and RENAME, header names for DELETE_HEADER.
+	// Negative index of a list counts from the end.
+	Keys []string `protobuf:"bytes,103,rep,name=keys,proto3" json:"keys,omitempty"`
+	// Destination path for RENAME.
+	To []string `protobuf:"bytes,104,rep,name=to,proto3" json:"to,omitempty"`
 }
 
 func (x *Template) Reset() {
@@ -691,6 +1454,20 @@ func (x *Template) GetValue() *Value {
 	return nil
 }
 
+func (x *Template) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *Template) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
 // What the Handler does.
 type Action struct {
 	state         protoimpl.MessageState
@@ -700,6 +1477,7 @@ type Action struct {
 	// Types that are assignable to Action:
 	//	*Action_Return_
 	//	*Action_Gateway_
+	//	*Action_Aggregate_
 	Action isAction_Action `protobuf_oneof:"action"`
 }
 
@@ -756,6 +1534,13 @@ func (x *Action) GetGateway() *Action_Gateway {
 	return nil
 }
 
+func (x *Action) GetAggregate() *Action_Aggregate {
+	if x, ok := x.GetAction().(*Action_Aggregate_); ok {
+		return x.Aggregate
+	}
+	return nil
+}
+
 type isAction_Action interface {
 	isAction_Action()
 }
@@ -768,22 +1553,57 @@ type Action_Gateway_ struct {
 	Gateway *Action_Gateway `protobuf:"bytes,102,opt,name=gateway,proto3,oneof"`
 }
 
+type Action_Aggregate_ struct {
+	Aggregate *Action_Aggregate `protobuf:"bytes,103,opt,name=aggregate,proto3,oneof"`
+}
+
 func (*Action_Return_) isAction_Action() {}
 
 func (*Action_Gateway_) isAction_Action() {}
 
-type Handler struct {
+func (*Action_Aggregate_) isAction_Action() {}
+
+// Settings of http client for gateways.
+// Zero values mean the defaults of net/http.
+// The client does not follow redirects, the redirect responses of the upstreams are returned as they are.
+type Client struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path       string     `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	MethodType MethodType `protobuf:"varint,2,opt,name=methodType,proto3,enum=jsonhttp.MethodType" json:"methodType,omitempty"`
-	Action     *Action    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-}
-
-func (x *Handler) Reset() {
-	*x = Handler{}
+	// Max idle connections across all hosts.
+	MaxIdleConns int32 `protobuf:"varint,1,opt,name=maxIdleConns,proto3" json:"maxIdleConns,omitempty"`
+	// Max idle connections per host.
+	MaxIdleConnsPerHost int32 `protobuf:"varint,2,opt,name=maxIdleConnsPerHost,proto3" json:"maxIdleConnsPerHost,omitempty"`
+	// Max connections per host, 0 means no limit.
+	MaxConnsPerHost int32 `protobuf:"varint,3,opt,name=maxConnsPerHost,proto3" json:"maxConnsPerHost,omitempty"`
+	// Idle connection timeout(millisecond).
+	IdleConnTimeout int32 `protobuf:"varint,4,opt,name=idleConnTimeout,proto3" json:"idleConnTimeout,omitempty"`
+	// Use a connection for only a single request.
+	DisableKeepAlives bool `protobuf:"varint,5,opt,name=disableKeepAlives,proto3" json:"disableKeepAlives,omitempty"`
+	// Dial timeout(millisecond).
+	DialTimeout int32 `protobuf:"varint,6,opt,name=dialTimeout,proto3" json:"dialTimeout,omitempty"`
+	// TCP keep-alive period(millisecond).
+	KeepAlive int32 `protobuf:"varint,7,opt,name=keepAlive,proto3" json:"keepAlive,omitempty"`
+	// TLS handshake timeout(millisecond).
+	TlsHandshakeTimeout int32 `protobuf:"varint,8,opt,name=tlsHandshakeTimeout,proto3" json:"tlsHandshakeTimeout,omitempty"`
+	// Timeout(millisecond) to wait for the response headers, 0 means no timeout.
+	ResponseHeaderTimeout int32 `protobuf:"varint,9,opt,name=responseHeaderTimeout,proto3" json:"responseHeaderTimeout,omitempty"`
+	// Proxy url.
+	// Empty means the environment variables HTTP_PROXY, HTTPS_PROXY and NO_PROXY.
+	Proxy string `protobuf:"bytes,10,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// Ignore proxy settings including the environment variables.
+	NoProxy bool `protobuf:"varint,11,opt,name=noProxy,proto3" json:"noProxy,omitempty"`
+	// PEM file of CA certificates to verify upstream servers, in addition to the system ones.
+	CaFile string `protobuf:"bytes,12,opt,name=caFile,proto3" json:"caFile,omitempty"`
+	// Skip verification of upstream certificates.
+	InsecureSkipVerify bool `protobuf:"varint,13,opt,name=insecureSkipVerify,proto3" json:"insecureSkipVerify,omitempty"`
+	// Disable HTTP/2.
+	DisableHttp2 bool `protobuf:"varint,14,opt,name=disableHttp2,proto3" json:"disableHttp2,omitempty"`
+}
+
+func (x *Client) Reset() {
+	*x = Client{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,13 +1611,13 @@ func (x *Handler) Reset() {
 	}
 }
 
-func (x *Handler) String() string {
+func (x *Client) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Handler) ProtoMessage() {}
+func (*Client) ProtoMessage() {}
 
-func (x *Handler) ProtoReflect() protoreflect.Message {
+func (x *Client) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,43 +1629,133 @@ func (x *Handler) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Handler.ProtoReflect.Descriptor instead.
-func (*Handler) Descriptor() ([]byte, []int) {
+// Deprecated: Use Client.ProtoReflect.Descriptor instead.
+func (*Client) Descriptor() ([]byte, []int) {
 	return file_origin_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Handler) GetPath() string {
+func (x *Client) GetMaxIdleConns() int32 {
 	if x != nil {
-		return x.Path
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *Client) GetMaxIdleConnsPerHost() int32 {
+	if x != nil {
+		return x.MaxIdleConnsPerHost
+	}
+	return 0
+}
+
+func (x *Client) GetMaxConnsPerHost() int32 {
+	if x != nil {
+		return x.MaxConnsPerHost
+	}
+	return 0
+}
+
+func (x *Client) GetIdleConnTimeout() int32 {
+	if x != nil {
+		return x.IdleConnTimeout
+	}
+	return 0
+}
+
+func (x *Client) GetDisableKeepAlives() bool {
+	if x != nil {
+		return x.DisableKeepAlives
+	}
+	return false
+}
+
+func (x *Client) GetDialTimeout() int32 {
+	if x != nil {
+		return x.DialTimeout
+	}
+	return 0
+}
+
+func (x *Client) GetKeepAlive() int32 {
+	if x != nil {
+		return x.KeepAlive
+	}
+	return 0
+}
+
+func (x *Client) GetTlsHandshakeTimeout() int32 {
+	if x != nil {
+		return x.TlsHandshakeTimeout
+	}
+	return 0
+}
+
+func (x *Client) GetResponseHeaderTimeout() int32 {
+	if x != nil {
+		return x.ResponseHeaderTimeout
+	}
+	return 0
+}
+
+func (x *Client) GetProxy() string {
+	if x != nil {
+		return x.Proxy
 	}
 	return ""
 }
 
-func (x *Handler) GetMethodType() MethodType {
+func (x *Client) GetNoProxy() bool {
 	if x != nil {
-		return x.MethodType
+		return x.NoProxy
 	}
-	return MethodType_GET
+	return false
 }
 
-func (x *Handler) GetAction() *Action {
+func (x *Client) GetCaFile() string {
 	if x != nil {
-		return x.Action
+		return x.CaFile
 	}
-	return nil
+	return ""
 }
 
-type Server struct {
+func (x *Client) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *Client) GetDisableHttp2() bool {
+	if x != nil {
+		return x.DisableHttp2
+	}
+	return false
+}
+
+// Retry policy of a gateway.
+type Retry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port     int32      `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
-	Handlers []*Handler `protobuf:"bytes,2,rep,name=handlers,proto3" json:"handlers,omitempty"`
-}
-
-func (x *Server) Reset() {
-	*x = Server{}
+	// Max number of attempts including the first one, 0 or 1 means no retry.
+	MaxAttempts int32 `protobuf:"varint,1,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	// Response statuses to retry, e.g. 502, 503.
+	Statuses []int32 `protobuf:"varint,2,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	// Retry on network errors including the timeout of an attempt.
+	NetworkErrors bool `protobuf:"varint,3,opt,name=networkErrors,proto3" json:"networkErrors,omitempty"`
+	// Wait(millisecond) before the second attempt, doubled per attempt.
+	Backoff int32 `protobuf:"varint,4,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// Max wait(millisecond), 0 means no limit.
+	MaxBackoff int32 `protobuf:"varint,5,opt,name=maxBackoff,proto3" json:"maxBackoff,omitempty"`
+	// Ratio in [0, 1] of the wait reduced randomly.
+	Jitter float64 `protobuf:"fixed64,6,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// Timeout(millisecond) of each attempt, 0 means no limit other than the timeout of the gateway.
+	AttemptTimeout int32 `protobuf:"varint,7,opt,name=attemptTimeout,proto3" json:"attemptTimeout,omitempty"`
+}
+
+func (x *Retry) Reset() {
+	*x = Retry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,13 +1763,13 @@ func (x *Server) Reset() {
 	}
 }
 
-func (x *Server) String() string {
+func (x *Retry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*Retry) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
+func (x *Retry) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -871,37 +1781,77 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
+// Deprecated: Use Retry.ProtoReflect.Descriptor instead.
+func (*Retry) Descriptor() ([]byte, []int) {
 	return file_origin_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Server) GetPort() int32 {
+func (x *Retry) GetMaxAttempts() int32 {
 	if x != nil {
-		return x.Port
+		return x.MaxAttempts
 	}
 	return 0
 }
 
-func (x *Server) GetHandlers() []*Handler {
+func (x *Retry) GetStatuses() []int32 {
 	if x != nil {
-		return x.Handlers
+		return x.Statuses
 	}
 	return nil
 }
 
-// Value template based on request headers.
-type Value_Header struct {
+func (x *Retry) GetNetworkErrors() bool {
+	if x != nil {
+		return x.NetworkErrors
+	}
+	return false
+}
+
+func (x *Retry) GetBackoff() int32 {
+	if x != nil {
+		return x.Backoff
+	}
+	return 0
+}
+
+func (x *Retry) GetMaxBackoff() int32 {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return 0
+}
+
+func (x *Retry) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *Retry) GetAttemptTimeout() int32 {
+	if x != nil {
+		return x.AttemptTimeout
+	}
+	return 0
+}
+
+// Upstream targets of a gateway.
+type Upstreams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Header name.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Targets  []*Upstreams_Target `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	Strategy Upstreams_Strategy  `protobuf:"varint,2,opt,name=strategy,proto3,enum=jsonhttp.Upstreams_Strategy" json:"strategy,omitempty"`
+	// Consecutive failures to eject a target, 0 means never.
+	// Network errors and statuses 5xx are failures.
+	MaxFails int32 `protobuf:"varint,3,opt,name=maxFails,proto3" json:"maxFails,omitempty"`
+	// Duration(millisecond) to exclude an ejected target.
+	EjectDuration int32 `protobuf:"varint,4,opt,name=ejectDuration,proto3" json:"ejectDuration,omitempty"`
 }
 
-func (x *Value_Header) Reset() {
-	*x = Value_Header{}
+func (x *Upstreams) Reset() {
+	*x = Upstreams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,13 +1859,13 @@ func (x *Value_Header) Reset() {
 	}
 }
 
-func (x *Value_Header) String() string {
+func (x *Upstreams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Header) ProtoMessage() {}
+func (*Upstreams) ProtoMessage() {}
 
-func (x *Value_Header) ProtoReflect() protoreflect.Message {
+func (x *Upstreams) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,42 +1877,66 @@ func (x *Value_Header) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Header.ProtoReflect.Descriptor instead.
-func (*Value_Header) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use Upstreams.ProtoReflect.Descriptor instead.
+func (*Upstreams) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Value_Header) GetKey() string {
+func (x *Upstreams) GetTargets() []*Upstreams_Target {
 	if x != nil {
-		return x.Key
+		return x.Targets
 	}
-	return ""
+	return nil
 }
 
-// Value template based on request body.
-type Value_Body struct {
+func (x *Upstreams) GetStrategy() Upstreams_Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Upstreams_ROUND_ROBIN
+}
+
+func (x *Upstreams) GetMaxFails() int32 {
+	if x != nil {
+		return x.MaxFails
+	}
+	return 0
+}
+
+func (x *Upstreams) GetEjectDuration() int32 {
+	if x != nil {
+		return x.EjectDuration
+	}
+	return 0
+}
+
+// Circuit breaker of a gateway.
+// Network errors and statuses 5xx are failures.
+type CircuitBreaker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Like js property accessor.
-	//
-	// # Example
-	//
-	// When body is below:
-	//
-	//     {
-	//       "top": {
-	//         "internal": "depth"
-	//       }
-	//     }
-	//
-	// and keys is ["top", "internal"], then get "depth".
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-}
-
-func (x *Value_Body) Reset() {
-	*x = Value_Body{}
+	// Name in the admin endpoint /admin/breakers, unique in the server.
+	// Names starting with # are reserved for the breakers without name, e.g. #0 for the first one.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Rate of failures in (0, 1] to open the breaker.
+	FailureRate float64 `protobuf:"fixed64,2,opt,name=failureRate,proto3" json:"failureRate,omitempty"`
+	// Requests in a window to evaluate failureRate.
+	MinRequests int32 `protobuf:"varint,3,opt,name=minRequests,proto3" json:"minRequests,omitempty"`
+	// Window(millisecond) to count requests, 0 means no reset.
+	Window int32 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	// Duration(millisecond) to reject requests before probing.
+	OpenDuration int32 `protobuf:"varint,5,opt,name=openDuration,proto3" json:"openDuration,omitempty"`
+	// Successful probes to close the breaker, 0 means 1.
+	Probes int32 `protobuf:"varint,6,opt,name=probes,proto3" json:"probes,omitempty"`
+	// Response while the breaker is open, status 503 if empty.
+	// The cached responses of the gateway take precedence over the fallback.
+	Fallback *Action_Return `protobuf:"bytes,7,opt,name=fallback,proto3" json:"fallback,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,13 +1944,13 @@ func (x *Value_Body) Reset() {
 	}
 }
 
-func (x *Value_Body) String() string {
+func (x *CircuitBreaker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Body) ProtoMessage() {}
+func (*CircuitBreaker) ProtoMessage() {}
 
-func (x *Value_Body) ProtoReflect() protoreflect.Message {
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,33 +1962,78 @@ func (x *Value_Body) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Body.ProtoReflect.Descriptor instead.
-func (*Value_Body) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Value_Body) GetKeys() []string {
+func (x *CircuitBreaker) GetName() string {
 	if x != nil {
-		return x.Keys
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetMinRequests() int32 {
+	if x != nil {
+		return x.MinRequests
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetOpenDuration() int32 {
+	if x != nil {
+		return x.OpenDuration
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetProbes() int32 {
+	if x != nil {
+		return x.Probes
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetFallback() *Action_Return {
+	if x != nil {
+		return x.Fallback
 	}
 	return nil
 }
 
-// Value template based on request url.
-type Value_Url struct {
+// Traffic mirroring of a gateway.
+// Copies of requests are sent to the shadows asynchronously and their responses are ignored.
+type Mirror struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Value:
-	//	*Value_Url_Part_
-	//	*Value_Url_Query_
-	//	*Value_Url_Path_
-	Value isValue_Url_Value `protobuf_oneof:"value"`
+	// Base urls of the shadows, e.g. http://127.0.0.1:10001
+	// The scheme and the host of the request url are replaced and the path is prefixed.
+	Urls []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Log differences of the status and the body between the primary response and the shadow responses.
+	Diff bool `protobuf:"varint,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout(millisecond) of a shadow request, 0 means 30 seconds.
+	Timeout int32 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *Value_Url) Reset() {
-	*x = Value_Url{}
+func (x *Mirror) Reset() {
+	*x = Mirror{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1022,13 +2041,13 @@ func (x *Value_Url) Reset() {
 	}
 }
 
-func (x *Value_Url) String() string {
+func (x *Mirror) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Url) ProtoMessage() {}
+func (*Mirror) ProtoMessage() {}
 
-func (x *Value_Url) ProtoReflect() protoreflect.Message {
+func (x *Mirror) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,75 +2059,57 @@ func (x *Value_Url) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Url.ProtoReflect.Descriptor instead.
-func (*Value_Url) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use Mirror.ProtoReflect.Descriptor instead.
+func (*Mirror) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{7}
 }
 
-func (m *Value_Url) GetValue() isValue_Url_Value {
-	if m != nil {
-		return m.Value
+func (x *Mirror) GetUrls() []string {
+	if x != nil {
+		return x.Urls
 	}
 	return nil
 }
 
-func (x *Value_Url) GetPart() Value_Url_Part {
-	if x, ok := x.GetValue().(*Value_Url_Part_); ok {
-		return x.Part
-	}
-	return Value_Url_SCHEME
-}
-
-func (x *Value_Url) GetQuery() *Value_Url_Query {
-	if x, ok := x.GetValue().(*Value_Url_Query_); ok {
-		return x.Query
+func (x *Mirror) GetDiff() bool {
+	if x != nil {
+		return x.Diff
 	}
-	return nil
+	return false
 }
 
-func (x *Value_Url) GetPath() *Value_Url_Path {
-	if x, ok := x.GetValue().(*Value_Url_Path_); ok {
-		return x.Path
+func (x *Mirror) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
 	}
-	return nil
-}
-
-type isValue_Url_Value interface {
-	isValue_Url_Value()
-}
-
-type Value_Url_Part_ struct {
-	Part Value_Url_Part `protobuf:"varint,101,opt,name=part,proto3,enum=jsonhttp.Value_Url_Part,oneof"`
-}
-
-type Value_Url_Query_ struct {
-	Query *Value_Url_Query `protobuf:"bytes,102,opt,name=query,proto3,oneof"`
-}
-
-type Value_Url_Path_ struct {
-	Path *Value_Url_Path `protobuf:"bytes,103,opt,name=path,proto3,oneof"`
+	return 0
 }
 
-func (*Value_Url_Part_) isValue_Url_Value() {}
-
-func (*Value_Url_Query_) isValue_Url_Value() {}
-
-func (*Value_Url_Path_) isValue_Url_Value() {}
-
-// Value template utilities.
-type Value_Util struct {
+type Cache struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Value:
-	//	*Value_Util_Now_
-	//	*Value_Util_Random_
-	Value isValue_Util_Value `protobuf_oneof:"value"`
-}
-
-func (x *Value_Util) Reset() {
-	*x = Value_Util{}
+	// Key of the cache, default is the method, the incoming url and the values of headers,
+	// and the hash of the request body unless the method is GET or HEAD.
+	Key *Value `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Request headers added to the default key.
+	Headers []string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Lifetime(millisecond) of a cached response, 0 means 60 seconds.
+	Ttl int32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Max number of cached responses, 0 means 1000.
+	Size int32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Honor Cache-Control of the request and the response.
+	// no-cache and no-store of the request bypass the cache,
+	// no-store, no-cache and private of the response are not cached,
+	// s-maxage and max-age of the response override ttl.
+	CacheControl bool `protobuf:"varint,5,opt,name=cacheControl,proto3" json:"cacheControl,omitempty"`
+	// Cacheable response statuses, default is 200.
+	Statuses []int32 `protobuf:"varint,6,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *Cache) Reset() {
+	*x = Cache{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1116,13 +2117,13 @@ func (x *Value_Util) Reset() {
 	}
 }
 
-func (x *Value_Util) String() string {
+func (x *Cache) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Util) ProtoMessage() {}
+func (*Cache) ProtoMessage() {}
 
-func (x *Value_Util) ProtoReflect() protoreflect.Message {
+func (x *Cache) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,60 +2135,72 @@ func (x *Value_Util) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Util.ProtoReflect.Descriptor instead.
-func (*Value_Util) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use Cache.ProtoReflect.Descriptor instead.
+func (*Cache) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *Value_Util) GetValue() isValue_Util_Value {
-	if m != nil {
-		return m.Value
+func (x *Cache) GetKey() *Value {
+	if x != nil {
+		return x.Key
 	}
 	return nil
 }
 
-func (x *Value_Util) GetNow() *Value_Util_Now {
-	if x, ok := x.GetValue().(*Value_Util_Now_); ok {
-		return x.Now
+func (x *Cache) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
 	}
 	return nil
 }
 
-func (x *Value_Util) GetRandom() *Value_Util_Random {
-	if x, ok := x.GetValue().(*Value_Util_Random_); ok {
-		return x.Random
+func (x *Cache) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
 	}
-	return nil
+	return 0
 }
 
-type isValue_Util_Value interface {
-	isValue_Util_Value()
+func (x *Cache) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-type Value_Util_Now_ struct {
-	Now *Value_Util_Now `protobuf:"bytes,101,opt,name=now,proto3,oneof"`
+func (x *Cache) GetCacheControl() bool {
+	if x != nil {
+		return x.CacheControl
+	}
+	return false
 }
 
-type Value_Util_Random_ struct {
-	Random *Value_Util_Random `protobuf:"bytes,102,opt,name=random,proto3,oneof"`
+func (x *Cache) GetStatuses() []int32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
 }
 
-func (*Value_Util_Now_) isValue_Util_Value() {}
-
-func (*Value_Util_Random_) isValue_Util_Value() {}
-
-// Add up values.
-type Value_Add struct {
+// Response statuses by the class of the upstream failures.
+// 0 means the default, 504 for timeout and 502 for the others.
+type ErrorStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   Value_Add_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Add_Type" json:"type,omitempty"`
-	Values []*Value       `protobuf:"bytes,102,rep,name=values,proto3" json:"values,omitempty"`
+	// The upstream did not respond in time.
+	Timeout int32 `protobuf:"varint,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// The connection to the upstream failed, e.g. connection refused.
+	Connection int32 `protobuf:"varint,2,opt,name=connection,proto3" json:"connection,omitempty"`
+	// The host of the upstream was not resolved.
+	Dns int32 `protobuf:"varint,3,opt,name=dns,proto3" json:"dns,omitempty"`
+	// The other failures, e.g. tls handshake, reading the response body.
+	Other int32 `protobuf:"varint,4,opt,name=other,proto3" json:"other,omitempty"`
 }
 
-func (x *Value_Add) Reset() {
-	*x = Value_Add{}
+func (x *ErrorStatus) Reset() {
+	*x = ErrorStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1195,13 +2208,13 @@ func (x *Value_Add) Reset() {
 	}
 }
 
-func (x *Value_Add) String() string {
+func (x *ErrorStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Add) ProtoMessage() {}
+func (*ErrorStatus) ProtoMessage() {}
 
-func (x *Value_Add) ProtoReflect() protoreflect.Message {
+func (x *ErrorStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1213,37 +2226,58 @@ func (x *Value_Add) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Add.ProtoReflect.Descriptor instead.
-func (*Value_Add) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 4}
+// Deprecated: Use ErrorStatus.ProtoReflect.Descriptor instead.
+func (*ErrorStatus) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Value_Add) GetType() Value_Add_Type {
+func (x *ErrorStatus) GetTimeout() int32 {
 	if x != nil {
-		return x.Type
+		return x.Timeout
 	}
-	return Value_Add_STRING
+	return 0
 }
 
-func (x *Value_Add) GetValues() []*Value {
+func (x *ErrorStatus) GetConnection() int32 {
 	if x != nil {
-		return x.Values
+		return x.Connection
 	}
-	return nil
+	return 0
 }
 
-// Typecast.
-type Value_Cast struct {
+func (x *ErrorStatus) GetDns() int32 {
+	if x != nil {
+		return x.Dns
+	}
+	return 0
+}
+
+func (x *ErrorStatus) GetOther() int32 {
+	if x != nil {
+		return x.Other
+	}
+	return 0
+}
+
+// Conditions of the request, all of them must be satisfied.
+type Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  Value_Cast_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Cast_Type" json:"type,omitempty"`
-	Value *Value          `protobuf:"bytes,102,opt,name=value,proto3" json:"value,omitempty"`
+	// Request headers with the values.
+	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Request queries with the values.
+	Queries map[string]string `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Request body fields by dot-separated keys with the values, e.g. items.0.name.
+	Body map[string]*Value `protobuf:"bytes,3,rep,name=body,proto3" json:"body,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Handle the request by the closest handler, the handler of the most satisfied conditions,
+	// if no handler of the same path and method is matched.
+	Closest bool `protobuf:"varint,4,opt,name=closest,proto3" json:"closest,omitempty"`
 }
 
-func (x *Value_Cast) Reset() {
-	*x = Value_Cast{}
+func (x *Match) Reset() {
+	*x = Match{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,13 +2285,13 @@ func (x *Value_Cast) Reset() {
 	}
 }
 
-func (x *Value_Cast) String() string {
+func (x *Match) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Cast) ProtoMessage() {}
+func (*Match) ProtoMessage() {}
 
-func (x *Value_Cast) ProtoReflect() protoreflect.Message {
+func (x *Match) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,35 +2303,53 @@ func (x *Value_Cast) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Cast.ProtoReflect.Descriptor instead.
-func (*Value_Cast) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 5}
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Value_Cast) GetType() Value_Cast_Type {
+func (x *Match) GetHeaders() map[string]string {
 	if x != nil {
-		return x.Type
+		return x.Headers
 	}
-	return Value_Cast_BOOL
+	return nil
 }
 
-func (x *Value_Cast) GetValue() *Value {
+func (x *Match) GetQueries() map[string]string {
 	if x != nil {
-		return x.Value
+		return x.Queries
 	}
 	return nil
 }
 
-type Value_List struct {
+func (x *Match) GetBody() map[string]*Value {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Match) GetClosest() bool {
+	if x != nil {
+		return x.Closest
+	}
+	return false
+}
+
+// JSON Schema.
+type Schema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values []*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	// Types that are assignable to Schema:
+	//	*Schema_Inline
+	//	*Schema_File
+	Schema isSchema_Schema `protobuf_oneof:"schema"`
 }
 
-func (x *Value_List) Reset() {
-	*x = Value_List{}
+func (x *Schema) Reset() {
+	*x = Schema{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1305,13 +2357,13 @@ func (x *Value_List) Reset() {
 	}
 }
 
-func (x *Value_List) String() string {
+func (x *Schema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_List) ProtoMessage() {}
+func (*Schema) ProtoMessage() {}
 
-func (x *Value_List) ProtoReflect() protoreflect.Message {
+func (x *Schema) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1323,28 +2375,63 @@ func (x *Value_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_List.ProtoReflect.Descriptor instead.
-func (*Value_List) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 6}
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Value_List) GetValues() []*Value {
-	if x != nil {
-		return x.Values
+func (m *Schema) GetSchema() isSchema_Schema {
+	if m != nil {
+		return m.Schema
 	}
 	return nil
 }
 
-type Value_Map struct {
+func (x *Schema) GetInline() *structpb.Struct {
+	if x, ok := x.GetSchema().(*Schema_Inline); ok {
+		return x.Inline
+	}
+	return nil
+}
+
+func (x *Schema) GetFile() string {
+	if x, ok := x.GetSchema().(*Schema_File); ok {
+		return x.File
+	}
+	return ""
+}
+
+type isSchema_Schema interface {
+	isSchema_Schema()
+}
+
+type Schema_Inline struct {
+	Inline *structpb.Struct `protobuf:"bytes,1,opt,name=inline,proto3,oneof"`
+}
+
+type Schema_File struct {
+	// File of the schema in json or yaml.
+	File string `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+func (*Schema_Inline) isSchema_Schema() {}
+
+func (*Schema_File) isSchema_Schema() {}
+
+// Operation of an OpenAPI 3 document.
+type OpenAPIOperation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values map[string]*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// File of the document in json or yaml.
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Path of the operation, e.g. /users/{id}, default is the path of the handler.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *Value_Map) Reset() {
-	*x = Value_Map{}
+func (x *OpenAPIOperation) Reset() {
+	*x = OpenAPIOperation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1352,13 +2439,13 @@ func (x *Value_Map) Reset() {
 	}
 }
 
-func (x *Value_Map) String() string {
+func (x *OpenAPIOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Map) ProtoMessage() {}
+func (*OpenAPIOperation) ProtoMessage() {}
 
-func (x *Value_Map) ProtoReflect() protoreflect.Message {
+func (x *OpenAPIOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1370,35 +2457,53 @@ func (x *Value_Map) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Map.ProtoReflect.Descriptor instead.
-func (*Value_Map) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 7}
+// Deprecated: Use OpenAPIOperation.ProtoReflect.Descriptor instead.
+func (*OpenAPIOperation) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Value_Map) GetValues() map[string]*Value {
+func (x *OpenAPIOperation) GetFile() string {
 	if x != nil {
-		return x.Values
+		return x.File
 	}
-	return nil
+	return ""
 }
 
-// Path of url.
-type Value_Url_Path struct {
+func (x *OpenAPIOperation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Check the request before the action,
+// respond the violations with the status instead of the action if the request is invalid.
+// The response body is an object like
+// {"result":"error","error":"invalid request","violations":[{"in":"body","path":"/name","message":"name is required"}]}.
+type Validation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Index of the part of path separated by /.
-	//
-	// # Examples
-	//
-	// For /a/b,
-	// index 0 means a, index 1 means b, other indexes are out of range.
-	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-}
-
-func (x *Value_Url_Path) Reset() {
-	*x = Value_Url_Path{}
+	// Schema of the json request body, the empty body is a violation.
+	Body *Schema `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	// Schema of the queries as an object of the names to the first values,
+	// or all the values if the property is an array.
+	// The values are converted into the types of the properties, e.g. integer.
+	Query *Schema `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Schema of the headers as an object of the canonical names, e.g. Content-Type, to the first values.
+	// The values are converted like query.
+	Header *Schema `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	// Validate the body, the queries, the headers and the path parameters by the operation of the method of the request.
+	// The empty body is a violation if the request body of the operation is required.
+	Openapi *OpenAPIOperation `protobuf:"bytes,4,opt,name=openapi,proto3" json:"openapi,omitempty"`
+	// Status of the violations, default is 422.
+	// Status of the malformed json body is 400.
+	Status int32 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *Validation) Reset() {
+	*x = Validation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1406,13 +2511,13 @@ func (x *Value_Url_Path) Reset() {
 	}
 }
 
-func (x *Value_Url_Path) String() string {
+func (x *Validation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Url_Path) ProtoMessage() {}
+func (*Validation) ProtoMessage() {}
 
-func (x *Value_Url_Path) ProtoReflect() protoreflect.Message {
+func (x *Validation) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1424,30 +2529,63 @@ func (x *Value_Url_Path) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Url_Path.ProtoReflect.Descriptor instead.
-func (*Value_Url_Path) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 2, 0}
+// Deprecated: Use Validation.ProtoReflect.Descriptor instead.
+func (*Validation) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Value_Url_Path) GetIndex() int32 {
+func (x *Validation) GetBody() *Schema {
 	if x != nil {
-		return x.Index
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Validation) GetQuery() *Schema {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *Validation) GetHeader() *Schema {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Validation) GetOpenapi() *OpenAPIOperation {
+	if x != nil {
+		return x.Openapi
+	}
+	return nil
+}
+
+func (x *Validation) GetStatus() int32 {
+	if x != nil {
+		return x.Status
 	}
 	return 0
 }
 
-// Query of url.
-type Value_Url_Query struct {
+// Replay the entries of a HAR archive as handlers after the handlers of Server,
+// matching the method, the path, the query and optionally the body by closest.
+// Binary response bodies are replayed by rawBytes.
+type Har struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Query key.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Match the top-level fields of the json request body.
+	MatchBody bool `protobuf:"varint,2,opt,name=matchBody,proto3" json:"matchBody,omitempty"`
+	// Response headers to replay, default is Content-Type.
+	ResponseHeaders []string `protobuf:"bytes,3,rep,name=responseHeaders,proto3" json:"responseHeaders,omitempty"`
 }
 
-func (x *Value_Url_Query) Reset() {
-	*x = Value_Url_Query{}
+func (x *Har) Reset() {
+	*x = Har{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1455,13 +2593,13 @@ func (x *Value_Url_Query) Reset() {
 	}
 }
 
-func (x *Value_Url_Query) String() string {
+func (x *Har) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Url_Query) ProtoMessage() {}
+func (*Har) ProtoMessage() {}
 
-func (x *Value_Url_Query) ProtoReflect() protoreflect.Message {
+func (x *Har) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1473,29 +2611,53 @@ func (x *Value_Url_Query) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Url_Query.ProtoReflect.Descriptor instead.
-func (*Value_Url_Query) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 2, 1}
+// Deprecated: Use Har.ProtoReflect.Descriptor instead.
+func (*Har) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Value_Url_Query) GetKey() string {
+func (x *Har) GetFile() string {
 	if x != nil {
-		return x.Key
+		return x.File
 	}
 	return ""
 }
 
-// Current time.
-type Value_Util_Now struct {
+func (x *Har) GetMatchBody() bool {
+	if x != nil {
+		return x.MatchBody
+	}
+	return false
+}
+
+func (x *Har) GetResponseHeaders() []string {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+// Record the exchanges through the gateway as handlers with Return,
+// matching the path, the method and the request parts selected by the options.
+type Record struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type Value_Util_Now_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Util_Now_Type" json:"type,omitempty"`
+	// Output file written as Server, the handlers in the file are kept.
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Request headers to match.
+	Headers []string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Request queries to match.
+	Queries []string `protobuf:"bytes,3,rep,name=queries,proto3" json:"queries,omitempty"`
+	// Request body fields to match by dot-separated keys.
+	Body []string `protobuf:"bytes,4,rep,name=body,proto3" json:"body,omitempty"`
+	// Response headers to replay, default is Content-Type.
+	ResponseHeaders []string `protobuf:"bytes,5,rep,name=responseHeaders,proto3" json:"responseHeaders,omitempty"`
 }
 
-func (x *Value_Util_Now) Reset() {
-	*x = Value_Util_Now{}
+func (x *Record) Reset() {
+	*x = Record{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_origin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1503,13 +2665,13 @@ func (x *Value_Util_Now) Reset() {
 	}
 }
 
-func (x *Value_Util_Now) String() string {
+func (x *Record) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Util_Now) ProtoMessage() {}
+func (*Record) ProtoMessage() {}
 
-func (x *Value_Util_Now) ProtoReflect() protoreflect.Message {
+func (x *Record) ProtoReflect() protoreflect.Message {
 	mi := &file_origin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1521,47 +2683,1926 @@ func (x *Value_Util_Now) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Util_Now.ProtoReflect.Descriptor instead.
-func (*Value_Util_Now) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 3, 0}
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Value_Util_Now) GetType() Value_Util_Now_Type {
+func (x *Record) GetFile() string {
 	if x != nil {
-		return x.Type
+		return x.File
 	}
-	return Value_Util_Now_TIMESTAMP
+	return ""
 }
 
-// Random data.
-type Value_Util_Random struct {
+func (x *Record) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Record) GetQueries() []string {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *Record) GetBody() []string {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Record) GetResponseHeaders() []string {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+type Handler struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Value:
-	//	*Value_Util_Random_Type_
-	//	*Value_Util_Random_Dice_
-	Value isValue_Util_Random_Value `protobuf_oneof:"value"`
+	// Pattern of http.ServeMux.
+	// Segments enclosed in braces are path parameters, e.g. /users/{id},
+	// matched against a segment of the request path.
+	Path       string     `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	MethodType MethodType `protobuf:"varint,2,opt,name=methodType,proto3,enum=jsonhttp.MethodType" json:"methodType,omitempty"`
+	Action     *Action    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Handle the request only if matched.
+	// The handlers of the same path are tried in order.
+	Match *Match `protobuf:"bytes,4,opt,name=match,proto3" json:"match,omitempty"`
+	// Validate the request before the action.
+	Validate *Validation `protobuf:"bytes,5,opt,name=validate,proto3" json:"validate,omitempty"`
+}
+
+func (x *Handler) Reset() {
+	*x = Handler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Handler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handler) ProtoMessage() {}
+
+func (x *Handler) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handler.ProtoReflect.Descriptor instead.
+func (*Handler) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Handler) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Handler) GetMethodType() MethodType {
+	if x != nil {
+		return x.MethodType
+	}
+	return MethodType_GET
+}
+
+func (x *Handler) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *Handler) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *Handler) GetValidate() *Validation {
+	if x != nil {
+		return x.Validate
+	}
+	return nil
+}
+
+type Server struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     int32      `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Handlers []*Handler `protobuf:"bytes,2,rep,name=handlers,proto3" json:"handlers,omitempty"`
+	// Http client shared by gateways.
+	Client *Client `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Hars   []*Har  `protobuf:"bytes,4,rep,name=hars,proto3" json:"hars,omitempty"`
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Server) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Server) GetHandlers() []*Handler {
+	if x != nil {
+		return x.Handlers
+	}
+	return nil
+}
+
+func (x *Server) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *Server) GetHars() []*Har {
+	if x != nil {
+		return x.Hars
+	}
+	return nil
+}
+
+// Value template based on request headers.
+type Value_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Header name.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Get all values of the header as List.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *Value_Header) Reset() {
+	*x = Value_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Header) ProtoMessage() {}
+
+func (x *Value_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Header.ProtoReflect.Descriptor instead.
+func (*Value_Header) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Value_Header) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Value_Header) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// Value template based on request body.
+type Value_Body struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Like js property accessor.
+	//
+	// # Example
+	//
+	// When body is below:
+	//
+	//     {
+	//       "top": {
+	//         "internal": "depth"
+	//       }
+	//     }
+	//
+	// and keys is ["top", "internal"], then get "depth".
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *Value_Body) Reset() {
+	*x = Value_Body{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Body) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Body) ProtoMessage() {}
+
+func (x *Value_Body) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Body.ProtoReflect.Descriptor instead.
+func (*Value_Body) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Value_Body) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Value template based on request url.
+type Value_Url struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*Value_Url_Part_
+	//	*Value_Url_Query_
+	//	*Value_Url_Path_
+	Value isValue_Url_Value `protobuf_oneof:"value"`
+}
+
+func (x *Value_Url) Reset() {
+	*x = Value_Url{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Url) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Url) ProtoMessage() {}
+
+func (x *Value_Url) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Url.ProtoReflect.Descriptor instead.
+func (*Value_Url) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (m *Value_Url) GetValue() isValue_Url_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Value_Url) GetPart() Value_Url_Part {
+	if x, ok := x.GetValue().(*Value_Url_Part_); ok {
+		return x.Part
+	}
+	return Value_Url_SCHEME
+}
+
+func (x *Value_Url) GetQuery() *Value_Url_Query {
+	if x, ok := x.GetValue().(*Value_Url_Query_); ok {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *Value_Url) GetPath() *Value_Url_Path {
+	if x, ok := x.GetValue().(*Value_Url_Path_); ok {
+		return x.Path
+	}
+	return nil
+}
+
+type isValue_Url_Value interface {
+	isValue_Url_Value()
+}
+
+type Value_Url_Part_ struct {
+	Part Value_Url_Part `protobuf:"varint,101,opt,name=part,proto3,enum=jsonhttp.Value_Url_Part,oneof"`
+}
+
+type Value_Url_Query_ struct {
+	Query *Value_Url_Query `protobuf:"bytes,102,opt,name=query,proto3,oneof"`
+}
+
+type Value_Url_Path_ struct {
+	Path *Value_Url_Path `protobuf:"bytes,103,opt,name=path,proto3,oneof"`
+}
+
+func (*Value_Url_Part_) isValue_Url_Value() {}
+
+func (*Value_Url_Query_) isValue_Url_Value() {}
+
+func (*Value_Url_Path_) isValue_Url_Value() {}
+
+// Value template based on request metadata.
+type Value_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Part Value_Request_Part `protobuf:"varint,1,opt,name=part,proto3,enum=jsonhttp.Value_Request_Part" json:"part,omitempty"`
+}
+
+func (x *Value_Request) Reset() {
+	*x = Value_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Request) ProtoMessage() {}
+
+func (x *Value_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Request.ProtoReflect.Descriptor instead.
+func (*Value_Request) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Value_Request) GetPart() Value_Request_Part {
+	if x != nil {
+		return x.Part
+	}
+	return Value_Request_METHOD
+}
+
+// Value template based on request cookies.
+type Value_Cookie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cookie name.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *Value_Cookie) Reset() {
+	*x = Value_Cookie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Cookie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Cookie) ProtoMessage() {}
+
+func (x *Value_Cookie) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Cookie.ProtoReflect.Descriptor instead.
+func (*Value_Cookie) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Value_Cookie) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Value template based on the whole of request data.
+type Value_Dump struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target Value_Dump_Target `protobuf:"varint,1,opt,name=target,proto3,enum=jsonhttp.Value_Dump_Target" json:"target,omitempty"`
+	// Values of HEADER and QUERY are List of all values,
+	// otherwise the first values.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *Value_Dump) Reset() {
+	*x = Value_Dump{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Dump) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Dump) ProtoMessage() {}
+
+func (x *Value_Dump) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Dump.ProtoReflect.Descriptor instead.
+func (*Value_Dump) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *Value_Dump) GetTarget() Value_Dump_Target {
+	if x != nil {
+		return x.Target
+	}
+	return Value_Dump_BODY
+}
+
+func (x *Value_Dump) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// Value template utilities.
+type Value_Util struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*Value_Util_Now_
+	//	*Value_Util_Random_
+	Value isValue_Util_Value `protobuf_oneof:"value"`
+}
+
+func (x *Value_Util) Reset() {
+	*x = Value_Util{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Util) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Util) ProtoMessage() {}
+
+func (x *Value_Util) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Util.ProtoReflect.Descriptor instead.
+func (*Value_Util) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (m *Value_Util) GetValue() isValue_Util_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Value_Util) GetNow() *Value_Util_Now {
+	if x, ok := x.GetValue().(*Value_Util_Now_); ok {
+		return x.Now
+	}
+	return nil
+}
+
+func (x *Value_Util) GetRandom() *Value_Util_Random {
+	if x, ok := x.GetValue().(*Value_Util_Random_); ok {
+		return x.Random
+	}
+	return nil
+}
+
+type isValue_Util_Value interface {
+	isValue_Util_Value()
+}
+
+type Value_Util_Now_ struct {
+	Now *Value_Util_Now `protobuf:"bytes,101,opt,name=now,proto3,oneof"`
+}
+
+type Value_Util_Random_ struct {
+	Random *Value_Util_Random `protobuf:"bytes,102,opt,name=random,proto3,oneof"`
+}
+
+func (*Value_Util_Now_) isValue_Util_Value() {}
+
+func (*Value_Util_Random_) isValue_Util_Value() {}
+
+// Add up values.
+type Value_Add struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   Value_Add_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Add_Type" json:"type,omitempty"`
+	Values []*Value       `protobuf:"bytes,102,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *Value_Add) Reset() {
+	*x = Value_Add{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Add) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Add) ProtoMessage() {}
+
+func (x *Value_Add) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Add.ProtoReflect.Descriptor instead.
+func (*Value_Add) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *Value_Add) GetType() Value_Add_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Value_Add_STRING
+}
+
+func (x *Value_Add) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Typecast.
+type Value_Cast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Value_Cast_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Cast_Type" json:"type,omitempty"`
+	Value *Value          `protobuf:"bytes,102,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Value_Cast) Reset() {
+	*x = Value_Cast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Cast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Cast) ProtoMessage() {}
+
+func (x *Value_Cast) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Cast.ProtoReflect.Descriptor instead.
+func (*Value_Cast) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 8}
+}
+
+func (x *Value_Cast) GetType() Value_Cast_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Value_Cast_BOOL
+}
+
+func (x *Value_Cast) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Parse json string into Value.
+type Value_JsonParse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Value_JsonParse) Reset() {
+	*x = Value_JsonParse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_JsonParse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_JsonParse) ProtoMessage() {}
+
+func (x *Value_JsonParse) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_JsonParse.ProtoReflect.Descriptor instead.
+func (*Value_JsonParse) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *Value_JsonParse) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Serialize Value into json string.
+type Value_JsonStringify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Value_JsonStringify) Reset() {
+	*x = Value_JsonStringify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_JsonStringify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_JsonStringify) ProtoMessage() {}
+
+func (x *Value_JsonStringify) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_JsonStringify.ProtoReflect.Descriptor instead.
+func (*Value_JsonStringify) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 10}
+}
+
+func (x *Value_JsonStringify) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Encode string.
+type Value_Encode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Value_Encode_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Encode_Type" json:"type,omitempty"`
+	Value *Value            `protobuf:"bytes,102,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Value_Encode) Reset() {
+	*x = Value_Encode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Encode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Encode) ProtoMessage() {}
+
+func (x *Value_Encode) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Encode.ProtoReflect.Descriptor instead.
+func (*Value_Encode) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 11}
+}
+
+func (x *Value_Encode) GetType() Value_Encode_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Value_Encode_HEX
+}
+
+func (x *Value_Encode) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Decode string.
+// The decoded bytes must be UTF-8, e.g. binary data like images is an error.
+type Value_Decode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Value_Encode_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Encode_Type" json:"type,omitempty"`
+	Value *Value            `protobuf:"bytes,102,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Value_Decode) Reset() {
+	*x = Value_Decode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Decode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Decode) ProtoMessage() {}
+
+func (x *Value_Decode) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Decode.ProtoReflect.Descriptor instead.
+func (*Value_Decode) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 12}
+}
+
+func (x *Value_Decode) GetType() Value_Encode_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Value_Encode_HEX
+}
+
+func (x *Value_Decode) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Digest of string.
+type Value_Hash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Value_Hash_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Hash_Type" json:"type,omitempty"`
+	Value *Value          `protobuf:"bytes,102,opt,name=value,proto3" json:"value,omitempty"`
+	// Secret key of HMAC.
+	// Calculate HMAC instead of plain digest if set.
+	Key *Value `protobuf:"bytes,103,opt,name=key,proto3" json:"key,omitempty"`
+	// Encoding of the digest.
+	Encoding Value_Encode_Type `protobuf:"varint,104,opt,name=encoding,proto3,enum=jsonhttp.Value_Encode_Type" json:"encoding,omitempty"`
+}
+
+func (x *Value_Hash) Reset() {
+	*x = Value_Hash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Hash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Hash) ProtoMessage() {}
+
+func (x *Value_Hash) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Hash.ProtoReflect.Descriptor instead.
+func (*Value_Hash) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 13}
+}
+
+func (x *Value_Hash) GetType() Value_Hash_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Value_Hash_MD5
+}
+
+func (x *Value_Hash) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Value_Hash) GetKey() *Value {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Value_Hash) GetEncoding() Value_Encode_Type {
+	if x != nil {
+		return x.Encoding
+	}
+	return Value_Encode_HEX
+}
+
+// Operations on List and Map.
+type Value_Collection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Value_Collection_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Collection_Type" json:"type,omitempty"`
+	// List or Map.
+	Value *Value   `protobuf:"bytes,102,opt,name=value,proto3" json:"value,omitempty"`
+	Args  []*Value `protobuf:"bytes,103,rep,name=args,proto3" json:"args,omitempty"`
+	// Template evaluated for each element, refer to the element by Item.
+	Item *Value `protobuf:"bytes,104,opt,name=item,proto3" json:"item,omitempty"`
+	// Sort in descending order.
+	Desc bool `protobuf:"varint,105,opt,name=desc,proto3" json:"desc,omitempty"`
+}
+
+func (x *Value_Collection) Reset() {
+	*x = Value_Collection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Collection) ProtoMessage() {}
+
+func (x *Value_Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Collection.ProtoReflect.Descriptor instead.
+func (*Value_Collection) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 14}
+}
+
+func (x *Value_Collection) GetType() Value_Collection_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Value_Collection_LEN
+}
+
+func (x *Value_Collection) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Value_Collection) GetArgs() []*Value {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Value_Collection) GetItem() *Value {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Value_Collection) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+// The element in Collection item.
+type Value_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Part Value_Item_Part `protobuf:"varint,1,opt,name=part,proto3,enum=jsonhttp.Value_Item_Part" json:"part,omitempty"`
+	// Like Body, dig into the element.
+	// Index of List is also available.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *Value_Item) Reset() {
+	*x = Value_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Item) ProtoMessage() {}
+
+func (x *Value_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Item.ProtoReflect.Descriptor instead.
+func (*Value_Item) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 15}
+}
+
+func (x *Value_Item) GetPart() Value_Item_Part {
+	if x != nil {
+		return x.Part
+	}
+	return Value_Item_VALUE
+}
+
+func (x *Value_Item) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type Value_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *Value_List) Reset() {
+	*x = Value_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_List) ProtoMessage() {}
+
+func (x *Value_List) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_List.ProtoReflect.Descriptor instead.
+func (*Value_List) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 16}
+}
+
+func (x *Value_List) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Value_Map struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values map[string]*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Value_Map) Reset() {
+	*x = Value_Map{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Map) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Map) ProtoMessage() {}
+
+func (x *Value_Map) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Map.ProtoReflect.Descriptor instead.
+func (*Value_Map) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 17}
+}
+
+func (x *Value_Map) GetValues() map[string]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Value template based on the response of a call of Aggregate.
+type Value_Upstream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the call.
+	Name string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Part Value_Upstream_Part `protobuf:"varint,2,opt,name=part,proto3,enum=jsonhttp.Value_Upstream_Part" json:"part,omitempty"`
+	// Keys of the body, whole body if empty.
+	// Header name for HEADER.
+	Keys []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *Value_Upstream) Reset() {
+	*x = Value_Upstream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Upstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Upstream) ProtoMessage() {}
+
+func (x *Value_Upstream) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Upstream.ProtoReflect.Descriptor instead.
+func (*Value_Upstream) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 18}
+}
+
+func (x *Value_Upstream) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Value_Upstream) GetPart() Value_Upstream_Part {
+	if x != nil {
+		return x.Part
+	}
+	return Value_Upstream_BODY
+}
+
+func (x *Value_Upstream) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Value template based on the path parameters of the handler.
+type Value_Param struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the parameter, e.g. id for /users/{id}.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Value_Param) Reset() {
+	*x = Value_Param{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Param) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Param) ProtoMessage() {}
+
+func (x *Value_Param) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Param.ProtoReflect.Descriptor instead.
+func (*Value_Param) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 19}
+}
+
+func (x *Value_Param) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Path of url.
+type Value_Url_Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the part of path separated by /.
+	//
+	// # Examples
+	//
+	// For /a/b,
+	// index 0 means a, index 1 means b, other indexes are out of range.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *Value_Url_Path) Reset() {
+	*x = Value_Url_Path{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Url_Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Url_Path) ProtoMessage() {}
+
+func (x *Value_Url_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Url_Path.ProtoReflect.Descriptor instead.
+func (*Value_Url_Path) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (x *Value_Url_Path) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// Query of url.
+type Value_Url_Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Query key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Get all values of the key as List.
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *Value_Url_Query) Reset() {
+	*x = Value_Url_Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Url_Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Url_Query) ProtoMessage() {}
+
+func (x *Value_Url_Query) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Url_Query.ProtoReflect.Descriptor instead.
+func (*Value_Url_Query) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 2, 1}
+}
+
+func (x *Value_Url_Query) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Value_Url_Query) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// Current time.
+type Value_Util_Now struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Value_Util_Now_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Util_Now_Type" json:"type,omitempty"`
+}
+
+func (x *Value_Util_Now) Reset() {
+	*x = Value_Util_Now{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Util_Now) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Util_Now) ProtoMessage() {}
+
+func (x *Value_Util_Now) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Util_Now.ProtoReflect.Descriptor instead.
+func (*Value_Util_Now) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 6, 0}
+}
+
+func (x *Value_Util_Now) GetType() Value_Util_Now_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Value_Util_Now_TIMESTAMP
+}
+
+// Random data.
+type Value_Util_Random struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*Value_Util_Random_Type_
+	//	*Value_Util_Random_Dice_
+	Value isValue_Util_Random_Value `protobuf_oneof:"value"`
+}
+
+func (x *Value_Util_Random) Reset() {
+	*x = Value_Util_Random{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Util_Random) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Util_Random) ProtoMessage() {}
+
+func (x *Value_Util_Random) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Util_Random.ProtoReflect.Descriptor instead.
+func (*Value_Util_Random) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 6, 1}
+}
+
+func (m *Value_Util_Random) GetValue() isValue_Util_Random_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Value_Util_Random) GetType() Value_Util_Random_Type {
+	if x, ok := x.GetValue().(*Value_Util_Random_Type_); ok {
+		return x.Type
+	}
+	return Value_Util_Random_UUID
+}
+
+func (x *Value_Util_Random) GetDice() *Value_Util_Random_Dice {
+	if x, ok := x.GetValue().(*Value_Util_Random_Dice_); ok {
+		return x.Dice
+	}
+	return nil
+}
+
+type isValue_Util_Random_Value interface {
+	isValue_Util_Random_Value()
+}
+
+type Value_Util_Random_Type_ struct {
+	Type Value_Util_Random_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Util_Random_Type,oneof"`
+}
+
+type Value_Util_Random_Dice_ struct {
+	Dice *Value_Util_Random_Dice `protobuf:"bytes,102,opt,name=dice,proto3,oneof"`
+}
+
+func (*Value_Util_Random_Type_) isValue_Util_Random_Value() {}
+
+func (*Value_Util_Random_Dice_) isValue_Util_Random_Value() {}
+
+// Random integer.
+type Value_Util_Random_Dice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *Value_Util_Random_Dice) Reset() {
+	*x = Value_Util_Random_Dice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Util_Random_Dice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Util_Random_Dice) ProtoMessage() {}
+
+func (x *Value_Util_Random_Dice) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Util_Random_Dice.ProtoReflect.Descriptor instead.
+func (*Value_Util_Random_Dice) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{0, 6, 1, 0}
+}
+
+func (x *Value_Util_Random_Dice) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *Value_Util_Random_Dice) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Wraps request.
+//
+// The raw body and headers are forwarded as they are if templates are empty,
+// and so are the raw body and headers of the response if responseTemplates are empty.
+// Otherwise the body must be a json object.
+type Action_Gateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       *Value     `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	MethodType MethodType `protobuf:"varint,2,opt,name=methodType,proto3,enum=jsonhttp.MethodType" json:"methodType,omitempty"`
+	// Request timeout(millisecond).
+	Timeout              *Value              `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Templates            []*Template         `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`
+	ResponseTemplates    []*Template         `protobuf:"bytes,5,rep,name=responseTemplates,proto3" json:"responseTemplates,omitempty"`
+	TemplateType         Action_TemplateType `protobuf:"varint,6,opt,name=templateType,proto3,enum=jsonhttp.Action_TemplateType" json:"templateType,omitempty"`
+	ResponseTemplateType Action_TemplateType `protobuf:"varint,7,opt,name=responseTemplateType,proto3,enum=jsonhttp.Action_TemplateType" json:"responseTemplateType,omitempty"`
+	// Transparent reverse proxy mode.
+	// path is the base url, the incoming method, path after stripPrefix and query are forwarded.
+	Passthrough bool `protobuf:"varint,8,opt,name=passthrough,proto3" json:"passthrough,omitempty"`
+	// Prefix of the incoming path removed before appending to the base url in passthrough,
+	// only if the prefix ends at a segment boundary, e.g. /api strips /api/x but not /apix.
+	StripPrefix string `protobuf:"bytes,9,opt,name=stripPrefix,proto3" json:"stripPrefix,omitempty"`
+	// Http client of this gateway, the client of the server if empty.
+	Client *Client `protobuf:"bytes,10,opt,name=client,proto3" json:"client,omitempty"`
+	Retry  *Retry  `protobuf:"bytes,11,opt,name=retry,proto3" json:"retry,omitempty"`
+	// Targets to balance requests.
+	// The url is the url of the selected target followed by path.
+	// Each attempt of retry selects a target.
+	Upstreams      *Upstreams      `protobuf:"bytes,12,opt,name=upstreams,proto3" json:"upstreams,omitempty"`
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,13,opt,name=circuitBreaker,proto3" json:"circuitBreaker,omitempty"`
+	Mirror         *Mirror         `protobuf:"bytes,14,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	// Cache responses from the upstream.
+	Cache *Cache `protobuf:"bytes,15,opt,name=cache,proto3" json:"cache,omitempty"`
+	// Response statuses of the upstream failures.
+	ErrorStatus *ErrorStatus `protobuf:"bytes,16,opt,name=errorStatus,proto3" json:"errorStatus,omitempty"`
+	// Record the exchanges as handlers replaying the responses.
+	Record *Record `protobuf:"bytes,17,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *Action_Gateway) Reset() {
+	*x = Action_Gateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_origin_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Action_Gateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action_Gateway) ProtoMessage() {}
+
+func (x *Action_Gateway) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action_Gateway.ProtoReflect.Descriptor instead.
+func (*Action_Gateway) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Action_Gateway) GetPath() *Value {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetMethodType() MethodType {
+	if x != nil {
+		return x.MethodType
+	}
+	return MethodType_GET
+}
+
+func (x *Action_Gateway) GetTimeout() *Value {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetResponseTemplates() []*Template {
+	if x != nil {
+		return x.ResponseTemplates
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetTemplateType() Action_TemplateType {
+	if x != nil {
+		return x.TemplateType
+	}
+	return Action_SELECT
+}
+
+func (x *Action_Gateway) GetResponseTemplateType() Action_TemplateType {
+	if x != nil {
+		return x.ResponseTemplateType
+	}
+	return Action_SELECT
+}
+
+func (x *Action_Gateway) GetPassthrough() bool {
+	if x != nil {
+		return x.Passthrough
+	}
+	return false
+}
+
+func (x *Action_Gateway) GetStripPrefix() string {
+	if x != nil {
+		return x.StripPrefix
+	}
+	return ""
+}
+
+func (x *Action_Gateway) GetClient() *Client {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetRetry() *Retry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetUpstreams() *Upstreams {
+	if x != nil {
+		return x.Upstreams
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetMirror() *Mirror {
+	if x != nil {
+		return x.Mirror
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetCache() *Cache {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetErrorStatus() *ErrorStatus {
+	if x != nil {
+		return x.ErrorStatus
+	}
+	return nil
+}
+
+func (x *Action_Gateway) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// Return response.
+type Action_Return struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*Template `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	// Response status, 0 means 200.
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Delay response(millisecond).
+	Delay        *Value              `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	TemplateType Action_TemplateType `protobuf:"varint,4,opt,name=templateType,proto3,enum=jsonhttp.Action_TemplateType" json:"templateType,omitempty"`
+	// Response status by Value, overrides status if set, 400 if not a valid status.
+	StatusValue *Value `protobuf:"bytes,5,opt,name=statusValue,proto3" json:"statusValue,omitempty"`
+	// Response body written as is instead of the body by templates, empty means not set.
+	Raw string `protobuf:"bytes,6,opt,name=raw,proto3" json:"raw,omitempty"`
+	// Binary response body like raw, base64 in json.
+	RawBytes []byte `protobuf:"bytes,7,opt,name=rawBytes,proto3" json:"rawBytes,omitempty"`
 }
 
-func (x *Value_Util_Random) Reset() {
-	*x = Value_Util_Random{}
+func (x *Action_Return) Reset() {
+	*x = Action_Return{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_origin_proto_msgTypes[16]
+		mi := &file_origin_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Value_Util_Random) String() string {
+func (x *Action_Return) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Util_Random) ProtoMessage() {}
+func (*Action_Return) ProtoMessage() {}
 
-func (x *Value_Util_Random) ProtoReflect() protoreflect.Message {
-	mi := &file_origin_proto_msgTypes[16]
+func (x *Action_Return) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,75 +4613,89 @@ func (x *Value_Util_Random) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Util_Random.ProtoReflect.Descriptor instead.
-func (*Value_Util_Random) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 3, 1}
+// Deprecated: Use Action_Return.ProtoReflect.Descriptor instead.
+func (*Action_Return) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{2, 1}
 }
 
-func (m *Value_Util_Random) GetValue() isValue_Util_Random_Value {
-	if m != nil {
-		return m.Value
+func (x *Action_Return) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
 	}
 	return nil
 }
 
-func (x *Value_Util_Random) GetType() Value_Util_Random_Type {
-	if x, ok := x.GetValue().(*Value_Util_Random_Type_); ok {
-		return x.Type
+func (x *Action_Return) GetStatus() int32 {
+	if x != nil {
+		return x.Status
 	}
-	return Value_Util_Random_UUID
+	return 0
 }
 
-func (x *Value_Util_Random) GetDice() *Value_Util_Random_Dice {
-	if x, ok := x.GetValue().(*Value_Util_Random_Dice_); ok {
-		return x.Dice
+func (x *Action_Return) GetDelay() *Value {
+	if x != nil {
+		return x.Delay
 	}
 	return nil
 }
 
-type isValue_Util_Random_Value interface {
-	isValue_Util_Random_Value()
+func (x *Action_Return) GetTemplateType() Action_TemplateType {
+	if x != nil {
+		return x.TemplateType
+	}
+	return Action_SELECT
 }
 
-type Value_Util_Random_Type_ struct {
-	Type Value_Util_Random_Type `protobuf:"varint,101,opt,name=type,proto3,enum=jsonhttp.Value_Util_Random_Type,oneof"`
+func (x *Action_Return) GetStatusValue() *Value {
+	if x != nil {
+		return x.StatusValue
+	}
+	return nil
 }
 
-type Value_Util_Random_Dice_ struct {
-	Dice *Value_Util_Random_Dice `protobuf:"bytes,102,opt,name=dice,proto3,oneof"`
+func (x *Action_Return) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
 }
 
-func (*Value_Util_Random_Type_) isValue_Util_Random_Value() {}
-
-func (*Value_Util_Random_Dice_) isValue_Util_Random_Value() {}
+func (x *Action_Return) GetRawBytes() []byte {
+	if x != nil {
+		return x.RawBytes
+	}
+	return nil
+}
 
-// Random integer.
-type Value_Util_Random_Dice struct {
+// Calls gateways and returns the response built from their responses.
+type Action_Aggregate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Calls []*Action_Aggregate_Call `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	// Response, the responses of the calls are available as Value.Upstream.
+	// The bodies of the calls by name if empty.
+	Return *Action_Return `protobuf:"bytes,2,opt,name=return,proto3" json:"return,omitempty"`
 }
 
-func (x *Value_Util_Random_Dice) Reset() {
-	*x = Value_Util_Random_Dice{}
+func (x *Action_Aggregate) Reset() {
+	*x = Action_Aggregate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_origin_proto_msgTypes[17]
+		mi := &file_origin_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Value_Util_Random_Dice) String() string {
+func (x *Action_Aggregate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value_Util_Random_Dice) ProtoMessage() {}
+func (*Action_Aggregate) ProtoMessage() {}
 
-func (x *Value_Util_Random_Dice) ProtoReflect() protoreflect.Message {
-	mi := &file_origin_proto_msgTypes[17]
+func (x *Action_Aggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,58 +4706,55 @@ func (x *Value_Util_Random_Dice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value_Util_Random_Dice.ProtoReflect.Descriptor instead.
-func (*Value_Util_Random_Dice) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{0, 3, 1, 0}
+// Deprecated: Use Action_Aggregate.ProtoReflect.Descriptor instead.
+func (*Action_Aggregate) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{2, 2}
 }
 
-func (x *Value_Util_Random_Dice) GetMin() int32 {
+func (x *Action_Aggregate) GetCalls() []*Action_Aggregate_Call {
 	if x != nil {
-		return x.Min
+		return x.Calls
 	}
-	return 0
+	return nil
 }
 
-func (x *Value_Util_Random_Dice) GetMax() int32 {
+func (x *Action_Aggregate) GetReturn() *Action_Return {
 	if x != nil {
-		return x.Max
+		return x.Return
 	}
-	return 0
+	return nil
 }
 
-// Wraps request.
-type Action_Gateway struct {
+type Action_Aggregate_Call struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path       *Value     `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	MethodType MethodType `protobuf:"varint,2,opt,name=methodType,proto3,enum=jsonhttp.MethodType" json:"methodType,omitempty"`
-	// Request timeout(millisecond).
-	Timeout              *Value              `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Templates            []*Template         `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`
-	ResponseTemplates    []*Template         `protobuf:"bytes,5,rep,name=responseTemplates,proto3" json:"responseTemplates,omitempty"`
-	TemplateType         Action_TemplateType `protobuf:"varint,6,opt,name=templateType,proto3,enum=jsonhttp.Action_TemplateType" json:"templateType,omitempty"`
-	ResponseTemplateType Action_TemplateType `protobuf:"varint,7,opt,name=responseTemplateType,proto3,enum=jsonhttp.Action_TemplateType" json:"responseTemplateType,omitempty"`
+	// Name of the call to refer the response by Value.Upstream.
+	Name    string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Gateway *Action_Gateway `protobuf:"bytes,2,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// Names of the calls to wait for.
+	// The calls without dependencies run concurrently.
+	After []string `protobuf:"bytes,3,rep,name=after,proto3" json:"after,omitempty"`
 }
 
-func (x *Action_Gateway) Reset() {
-	*x = Action_Gateway{}
+func (x *Action_Aggregate_Call) Reset() {
+	*x = Action_Aggregate_Call{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_origin_proto_msgTypes[19]
+		mi := &file_origin_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Action_Gateway) String() string {
+func (x *Action_Aggregate_Call) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Action_Gateway) ProtoMessage() {}
+func (*Action_Aggregate_Call) ProtoMessage() {}
 
-func (x *Action_Gateway) ProtoReflect() protoreflect.Message {
-	mi := &file_origin_proto_msgTypes[19]
+func (x *Action_Aggregate_Call) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,91 +4765,60 @@ func (x *Action_Gateway) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Action_Gateway.ProtoReflect.Descriptor instead.
-func (*Action_Gateway) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *Action_Gateway) GetPath() *Value {
-	if x != nil {
-		return x.Path
-	}
-	return nil
-}
-
-func (x *Action_Gateway) GetMethodType() MethodType {
-	if x != nil {
-		return x.MethodType
-	}
-	return MethodType_GET
+// Deprecated: Use Action_Aggregate_Call.ProtoReflect.Descriptor instead.
+func (*Action_Aggregate_Call) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{2, 2, 0}
 }
 
-func (x *Action_Gateway) GetTimeout() *Value {
+func (x *Action_Aggregate_Call) GetName() string {
 	if x != nil {
-		return x.Timeout
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Action_Gateway) GetTemplates() []*Template {
+func (x *Action_Aggregate_Call) GetGateway() *Action_Gateway {
 	if x != nil {
-		return x.Templates
+		return x.Gateway
 	}
 	return nil
 }
 
-func (x *Action_Gateway) GetResponseTemplates() []*Template {
+func (x *Action_Aggregate_Call) GetAfter() []string {
 	if x != nil {
-		return x.ResponseTemplates
+		return x.After
 	}
 	return nil
 }
 
-func (x *Action_Gateway) GetTemplateType() Action_TemplateType {
-	if x != nil {
-		return x.TemplateType
-	}
-	return Action_SELECT
-}
-
-func (x *Action_Gateway) GetResponseTemplateType() Action_TemplateType {
-	if x != nil {
-		return x.ResponseTemplateType
-	}
-	return Action_SELECT
-}
-
-// Return response.
-type Action_Return struct {
+type Upstreams_Target struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Templates []*Template `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
-	// Response status.
-	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	// Delay response(millisecond).
-	Delay        *Value              `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
-	TemplateType Action_TemplateType `protobuf:"varint,4,opt,name=templateType,proto3,enum=jsonhttp.Action_TemplateType" json:"templateType,omitempty"`
+	// Base url, e.g. http://127.0.0.1:10000
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Weight for WEIGHTED, 0 means 1.
+	Weight int32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (x *Action_Return) Reset() {
-	*x = Action_Return{}
+func (x *Upstreams_Target) Reset() {
+	*x = Upstreams_Target{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_origin_proto_msgTypes[20]
+		mi := &file_origin_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Action_Return) String() string {
+func (x *Upstreams_Target) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Action_Return) ProtoMessage() {}
+func (*Upstreams_Target) ProtoMessage() {}
 
-func (x *Action_Return) ProtoReflect() protoreflect.Message {
-	mi := &file_origin_proto_msgTypes[20]
+func (x *Upstreams_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_origin_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,46 +4829,32 @@ func (x *Action_Return) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Action_Return.ProtoReflect.Descriptor instead.
-func (*Action_Return) Descriptor() ([]byte, []int) {
-	return file_origin_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use Upstreams_Target.ProtoReflect.Descriptor instead.
+func (*Upstreams_Target) Descriptor() ([]byte, []int) {
+	return file_origin_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *Action_Return) GetTemplates() []*Template {
+func (x *Upstreams_Target) GetUrl() string {
 	if x != nil {
-		return x.Templates
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *Action_Return) GetStatus() int32 {
+func (x *Upstreams_Target) GetWeight() int32 {
 	if x != nil {
-		return x.Status
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *Action_Return) GetDelay() *Value {
-	if x != nil {
-		return x.Delay
-	}
-	return nil
-}
-
-func (x *Action_Return) GetTemplateType() Action_TemplateType {
-	if x != nil {
-		return x.TemplateType
-	}
-	return Action_SELECT
-}
-
 var File_origin_proto protoreflect.FileDescriptor
 
 var file_origin_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
 	0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x0d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x1e, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
 	0x12, 0x30, 0x0a, 0x04, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x75,
@@ -1875,30 +4882,95 @@ var file_origin_proto_rawDesc = []byte{
 	0x64, 0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x61, 0x73,
 	0x74, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74,
 	0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x48, 0x00, 0x52,
-	0x04, 0x63, 0x61, 0x73, 0x74, 0x1a, 0x1a, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x1a, 0x1a, 0x0a, 0x04, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x1a, 0xbd, 0x02,
-	0x0a, 0x03, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x65, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x55, 0x72, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52,
-	0x04, 0x70, 0x61, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x66,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x55, 0x72, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
-	0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
-	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x55, 0x72, 0x6c, 0x2e, 0x50, 0x61, 0x74, 0x68,
-	0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x1c, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x19, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x22, 0x61, 0x0a, 0x04, 0x50, 0x61, 0x72, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x43, 0x48,
-	0x45, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49,
-	0x54, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x08,
-	0x0a, 0x04, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x54, 0x48,
-	0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x05, 0x12, 0x0c, 0x0a,
-	0x08, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x41,
-	0x4c, 0x4c, 0x10, 0x07, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x91, 0x03,
+	0x04, 0x63, 0x61, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x70, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x14, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x71, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x72, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x6b,
+	0x69, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x2a, 0x0a, 0x04,
+	0x64, 0x75, 0x6d, 0x70, 0x18, 0x74, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x44, 0x75, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x04, 0x64, 0x75, 0x6d, 0x70, 0x12, 0x39, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x18, 0x75, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4a, 0x73, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x69, 0x66, 0x79, 0x18, 0x76, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4a, 0x73, 0x6f, 0x6e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x6a, 0x73, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x69, 0x66, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x77, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x06, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2a,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x79, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x7a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x7b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x36, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x7c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x48, 0x00, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2d, 0x0a, 0x05,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x2c, 0x0a, 0x06, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x1a, 0x1a, 0x0a, 0x04, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x1a, 0xcf, 0x02, 0x0a, 0x03, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a,
+	0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x55, 0x72, 0x6c,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x72, 0x74, 0x12, 0x31, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x55, 0x72,
+	0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x2e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e,
+	0x55, 0x72, 0x6c, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x1a, 0x1c, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x2b,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x61, 0x0a, 0x04, 0x50,
+	0x61, 0x72, 0x74, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x52, 0x54,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x54, 0x48, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
+	0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x52, 0x41, 0x47, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x07, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xa0, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x52,
+	0x04, 0x70, 0x61, 0x72, 0x74, 0x22, 0x63, 0x0a, 0x04, 0x50, 0x61, 0x72, 0x74, 0x12, 0x0a, 0x0a,
+	0x06, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4d,
+	0x4f, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45,
+	0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e,
+	0x54, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x05, 0x1a, 0x1a, 0x0a, 0x06, 0x43, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x84, 0x01, 0x0a, 0x04, 0x44, 0x75, 0x6d, 0x70, 0x12,
+	0x33, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x2e, 0x44, 0x75, 0x6d, 0x70, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x35, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45,
+	0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x03, 0x1a, 0x91, 0x03,
 	0x0a, 0x04, 0x55, 0x74, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x6f, 0x77, 0x18, 0x65, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x2e, 0x4e, 0x6f, 0x77, 0x48, 0x00, 0x52,
@@ -1940,93 +5012,410 @@ var file_origin_proto_rawDesc = []byte{
 	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x28,
 	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x00,
 	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x2f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x27, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x03, 0x4d, 0x61,
-	0x70, 0x12, 0x37, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4a, 0x0a, 0x0b, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f,
-	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x7c, 0x0a, 0x08, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
-	0x68, 0x74, 0x74, 0x70, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x79,
+	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x32, 0x0a, 0x09, 0x4a, 0x73, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x36, 0x0a, 0x0d,
+	0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x69, 0x66, 0x79, 0x12, 0x25, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x96, 0x01, 0x0a, 0x06, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x07, 0x0a, 0x03, 0x48, 0x45, 0x58, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45,
+	0x36, 0x34, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x5f, 0x55,
+	0x52, 0x4c, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x10, 0x03, 0x1a, 0x60, 0x0a,
+	0x06, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79,
 	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74,
-	0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x1c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x44, 0x59, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x22, 0xfe, 0x05,
-	0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68,
-	0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
-	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x00, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x1a, 0x99, 0x03, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x23, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73,
-	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x34, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
-	0x70, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
-	0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
-	0x70, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x52, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
-	0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x74, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68,
-	0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x14, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xbc, 0x01,
-	0x0a, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x73,
-	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
-	0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
-	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x26, 0x0a, 0x0c,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x50, 0x50, 0x45,
-	0x4e, 0x44, 0x10, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d,
-	0x0a, 0x07, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a,
+	0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0xeb, 0x01, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x68, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x31, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x35, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x48, 0x41, 0x31, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x03, 0x1a, 0xcc, 0x02,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x67, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x23, 0x0a,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x22, 0x83, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x07, 0x0a, 0x03, 0x4c, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4c, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x50, 0x10,
+	0x04, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x55,
+	0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45,
+	0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x49, 0x43, 0x4b, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04,
+	0x4f, 0x4d, 0x49, 0x54, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x45, 0x59, 0x53, 0x10, 0x0a,
+	0x12, 0x0a, 0x0a, 0x06, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x0b, 0x1a, 0x65, 0x0a, 0x04,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x2d, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x52, 0x04, 0x70,
+	0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x1a, 0x0a, 0x04, 0x50, 0x61, 0x72, 0x74, 0x12,
+	0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4b, 0x45,
+	0x59, 0x10, 0x01, 0x1a, 0x2f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x37, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x4d, 0x61,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4a, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x8f, 0x01, 0x0a, 0x08, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x52,
+	0x04, 0x70, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x28, 0x0a, 0x04, 0x50, 0x61, 0x72,
+	0x74, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x41, 0x44, 0x45,
+	0x52, 0x10, 0x02, 0x1a, 0x1b, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x08, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x66, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x67, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x68, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x5c,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x12, 0x11, 0x0a,
+	0x0d, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x05,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x06, 0x22, 0xb5, 0x0c, 0x0a,
+	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74,
+	0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x00, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x12, 0x3a, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x67, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x1a, 0xd7, 0x06, 0x0a,
+	0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a,
 	0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x65, 0x74,
 	0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a,
-	0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x68,
-	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-	0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73, 0x2a, 0x1f, 0x0a, 0x0a, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x42, 0x23, 0x5a, 0x21, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x65, 0x72, 0x71, 0x75, 0x65,
-	0x72, 0x61, 0x6e, 0x74, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x30,
+	0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x40, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68,
+	0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x14, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73,
+	0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70,
+	0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74,
+	0x72, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x74, 0x72, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x31, 0x0a,
+	0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x40, 0x0a, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68,
+	0x74, 0x74, 0x70, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x05,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x05, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68,
+	0x74, 0x74, 0x70, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x9d, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68,
+	0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61,
+	0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61,
+	0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0xd9, 0x01, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x1a, 0x64, 0x0a, 0x04,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x22, 0x26, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x04, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x13, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x50, 0x65,
+	0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e,
+	0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x0f, 0x69, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x64, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x65,
+	0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x69,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x65,
+	0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6b, 0x65,
+	0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x6c, 0x73, 0x48, 0x61,
+	0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x74, 0x6c, 0x73, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61,
+	0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69,
+	0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x48, 0x74, 0x74, 0x70, 0x32, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x74, 0x74, 0x70, 0x32, 0x22, 0xe5, 0x01, 0x0a, 0x05,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x22, 0xbf, 0x02, 0x0a, 0x09, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x55, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x32, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4c, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42,
+	0x49, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x53, 0x54, 0x41, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0xf1, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6f, 0x70, 0x65, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52,
+	0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x4a, 0x0a, 0x06, 0x4d, 0x69, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x05, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12,
+	0x21, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x22, 0x6f, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x22, 0x82, 0x03, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x42, 0x6f, 0x64,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x48,
+	0x0a, 0x09, 0x42, 0x6f, 0x64, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x31, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x06, 0x69,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x3a, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x50, 0x49,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0xd2, 0x01, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x28,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x50, 0x49, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x61, 0x0a, 0x03, 0x48, 0x61, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x06, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x07, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x0a, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x30, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x73, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x68,
+	0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x61, 0x72, 0x52, 0x04, 0x68, 0x61, 0x72, 0x73, 0x2a, 0x5f,
+	0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x45, 0x41, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x53, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x07, 0x42,
+	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x65,
+	0x72, 0x71, 0x75, 0x65, 0x72, 0x61, 0x6e, 0x74, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x68, 0x74, 0x74,
+	0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2041,87 +5430,187 @@ func file_origin_proto_rawDescGZIP() []byte {
 	return file_origin_proto_rawDescData
 }
 
-var file_origin_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_origin_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_origin_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_origin_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_origin_proto_goTypes = []interface{}{
 	(MethodType)(0),                // 0: jsonhttp.MethodType
 	(Value_Url_Part)(0),            // 1: jsonhttp.Value.Url.Part
-	(Value_Util_Now_Type)(0),       // 2: jsonhttp.Value.Util.Now.Type
-	(Value_Util_Random_Type)(0),    // 3: jsonhttp.Value.Util.Random.Type
-	(Value_Add_Type)(0),            // 4: jsonhttp.Value.Add.Type
-	(Value_Cast_Type)(0),           // 5: jsonhttp.Value.Cast.Type
-	(Template_Type)(0),             // 6: jsonhttp.Template.Type
-	(Action_TemplateType)(0),       // 7: jsonhttp.Action.TemplateType
-	(*Value)(nil),                  // 8: jsonhttp.Value
-	(*Template)(nil),               // 9: jsonhttp.Template
-	(*Action)(nil),                 // 10: jsonhttp.Action
-	(*Handler)(nil),                // 11: jsonhttp.Handler
-	(*Server)(nil),                 // 12: jsonhttp.Server
-	(*Value_Header)(nil),           // 13: jsonhttp.Value.Header
-	(*Value_Body)(nil),             // 14: jsonhttp.Value.Body
-	(*Value_Url)(nil),              // 15: jsonhttp.Value.Url
-	(*Value_Util)(nil),             // 16: jsonhttp.Value.Util
-	(*Value_Add)(nil),              // 17: jsonhttp.Value.Add
-	(*Value_Cast)(nil),             // 18: jsonhttp.Value.Cast
-	(*Value_List)(nil),             // 19: jsonhttp.Value.List
-	(*Value_Map)(nil),              // 20: jsonhttp.Value.Map
-	(*Value_Url_Path)(nil),         // 21: jsonhttp.Value.Url.Path
-	(*Value_Url_Query)(nil),        // 22: jsonhttp.Value.Url.Query
-	(*Value_Util_Now)(nil),         // 23: jsonhttp.Value.Util.Now
-	(*Value_Util_Random)(nil),      // 24: jsonhttp.Value.Util.Random
-	(*Value_Util_Random_Dice)(nil), // 25: jsonhttp.Value.Util.Random.Dice
-	nil,                            // 26: jsonhttp.Value.Map.ValuesEntry
-	(*Action_Gateway)(nil),         // 27: jsonhttp.Action.Gateway
-	(*Action_Return)(nil),          // 28: jsonhttp.Action.Return
-	(structpb.NullValue)(0),        // 29: google.protobuf.NullValue
+	(Value_Request_Part)(0),        // 2: jsonhttp.Value.Request.Part
+	(Value_Dump_Target)(0),         // 3: jsonhttp.Value.Dump.Target
+	(Value_Util_Now_Type)(0),       // 4: jsonhttp.Value.Util.Now.Type
+	(Value_Util_Random_Type)(0),    // 5: jsonhttp.Value.Util.Random.Type
+	(Value_Add_Type)(0),            // 6: jsonhttp.Value.Add.Type
+	(Value_Cast_Type)(0),           // 7: jsonhttp.Value.Cast.Type
+	(Value_Encode_Type)(0),         // 8: jsonhttp.Value.Encode.Type
+	(Value_Hash_Type)(0),           // 9: jsonhttp.Value.Hash.Type
+	(Value_Collection_Type)(0),     // 10: jsonhttp.Value.Collection.Type
+	(Value_Item_Part)(0),           // 11: jsonhttp.Value.Item.Part
+	(Value_Upstream_Part)(0),       // 12: jsonhttp.Value.Upstream.Part
+	(Template_Type)(0),             // 13: jsonhttp.Template.Type
+	(Action_TemplateType)(0),       // 14: jsonhttp.Action.TemplateType
+	(Upstreams_Strategy)(0),        // 15: jsonhttp.Upstreams.Strategy
+	(*Value)(nil),                  // 16: jsonhttp.Value
+	(*Template)(nil),               // 17: jsonhttp.Template
+	(*Action)(nil),                 // 18: jsonhttp.Action
+	(*Client)(nil),                 // 19: jsonhttp.Client
+	(*Retry)(nil),                  // 20: jsonhttp.Retry
+	(*Upstreams)(nil),              // 21: jsonhttp.Upstreams
+	(*CircuitBreaker)(nil),         // 22: jsonhttp.CircuitBreaker
+	(*Mirror)(nil),                 // 23: jsonhttp.Mirror
+	(*Cache)(nil),                  // 24: jsonhttp.Cache
+	(*ErrorStatus)(nil),            // 25: jsonhttp.ErrorStatus
+	(*Match)(nil),                  // 26: jsonhttp.Match
+	(*Schema)(nil),                 // 27: jsonhttp.Schema
+	(*OpenAPIOperation)(nil),       // 28: jsonhttp.OpenAPIOperation
+	(*Validation)(nil),             // 29: jsonhttp.Validation
+	(*Har)(nil),                    // 30: jsonhttp.Har
+	(*Record)(nil),                 // 31: jsonhttp.Record
+	(*Handler)(nil),                // 32: jsonhttp.Handler
+	(*Server)(nil),                 // 33: jsonhttp.Server
+	(*Value_Header)(nil),           // 34: jsonhttp.Value.Header
+	(*Value_Body)(nil),             // 35: jsonhttp.Value.Body
+	(*Value_Url)(nil),              // 36: jsonhttp.Value.Url
+	(*Value_Request)(nil),          // 37: jsonhttp.Value.Request
+	(*Value_Cookie)(nil),           // 38: jsonhttp.Value.Cookie
+	(*Value_Dump)(nil),             // 39: jsonhttp.Value.Dump
+	(*Value_Util)(nil),             // 40: jsonhttp.Value.Util
+	(*Value_Add)(nil),              // 41: jsonhttp.Value.Add
+	(*Value_Cast)(nil),             // 42: jsonhttp.Value.Cast
+	(*Value_JsonParse)(nil),        // 43: jsonhttp.Value.JsonParse
+	(*Value_JsonStringify)(nil),    // 44: jsonhttp.Value.JsonStringify
+	(*Value_Encode)(nil),           // 45: jsonhttp.Value.Encode
+	(*Value_Decode)(nil),           // 46: jsonhttp.Value.Decode
+	(*Value_Hash)(nil),             // 47: jsonhttp.Value.Hash
+	(*Value_Collection)(nil),       // 48: jsonhttp.Value.Collection
+	(*Value_Item)(nil),             // 49: jsonhttp.Value.Item
+	(*Value_List)(nil),             // 50: jsonhttp.Value.List
+	(*Value_Map)(nil),              // 51: jsonhttp.Value.Map
+	(*Value_Upstream)(nil),         // 52: jsonhttp.Value.Upstream
+	(*Value_Param)(nil),            // 53: jsonhttp.Value.Param
+	(*Value_Url_Path)(nil),         // 54: jsonhttp.Value.Url.Path
+	(*Value_Url_Query)(nil),        // 55: jsonhttp.Value.Url.Query
+	(*Value_Util_Now)(nil),         // 56: jsonhttp.Value.Util.Now
+	(*Value_Util_Random)(nil),      // 57: jsonhttp.Value.Util.Random
+	(*Value_Util_Random_Dice)(nil), // 58: jsonhttp.Value.Util.Random.Dice
+	nil,                            // 59: jsonhttp.Value.Map.ValuesEntry
+	(*Action_Gateway)(nil),         // 60: jsonhttp.Action.Gateway
+	(*Action_Return)(nil),          // 61: jsonhttp.Action.Return
+	(*Action_Aggregate)(nil),       // 62: jsonhttp.Action.Aggregate
+	(*Action_Aggregate_Call)(nil),  // 63: jsonhttp.Action.Aggregate.Call
+	(*Upstreams_Target)(nil),       // 64: jsonhttp.Upstreams.Target
+	nil,                            // 65: jsonhttp.Match.HeadersEntry
+	nil,                            // 66: jsonhttp.Match.QueriesEntry
+	nil,                            // 67: jsonhttp.Match.BodyEntry
+	(structpb.NullValue)(0),        // 68: google.protobuf.NullValue
+	(*structpb.Struct)(nil),        // 69: google.protobuf.Struct
 }
 var file_origin_proto_depIdxs = []int32{
-	29, // 0: jsonhttp.Value.null:type_name -> google.protobuf.NullValue
-	19, // 1: jsonhttp.Value.l:type_name -> jsonhttp.Value.List
-	20, // 2: jsonhttp.Value.m:type_name -> jsonhttp.Value.Map
-	13, // 3: jsonhttp.Value.header:type_name -> jsonhttp.Value.Header
-	14, // 4: jsonhttp.Value.body:type_name -> jsonhttp.Value.Body
-	15, // 5: jsonhttp.Value.url:type_name -> jsonhttp.Value.Url
-	16, // 6: jsonhttp.Value.util:type_name -> jsonhttp.Value.Util
-	17, // 7: jsonhttp.Value.add:type_name -> jsonhttp.Value.Add
-	18, // 8: jsonhttp.Value.cast:type_name -> jsonhttp.Value.Cast
-	6,  // 9: jsonhttp.Template.type:type_name -> jsonhttp.Template.Type
-	8,  // 10: jsonhttp.Template.value:type_name -> jsonhttp.Value
-	28, // 11: jsonhttp.Action.return:type_name -> jsonhttp.Action.Return
-	27, // 12: jsonhttp.Action.gateway:type_name -> jsonhttp.Action.Gateway
-	0,  // 13: jsonhttp.Handler.methodType:type_name -> jsonhttp.MethodType
-	10, // 14: jsonhttp.Handler.action:type_name -> jsonhttp.Action
-	11, // 15: jsonhttp.Server.handlers:type_name -> jsonhttp.Handler
-	1,  // 16: jsonhttp.Value.Url.part:type_name -> jsonhttp.Value.Url.Part
-	22, // 17: jsonhttp.Value.Url.query:type_name -> jsonhttp.Value.Url.Query
-	21, // 18: jsonhttp.Value.Url.path:type_name -> jsonhttp.Value.Url.Path
-	23, // 19: jsonhttp.Value.Util.now:type_name -> jsonhttp.Value.Util.Now
-	24, // 20: jsonhttp.Value.Util.random:type_name -> jsonhttp.Value.Util.Random
-	4,  // 21: jsonhttp.Value.Add.type:type_name -> jsonhttp.Value.Add.Type
-	8,  // 22: jsonhttp.Value.Add.values:type_name -> jsonhttp.Value
-	5,  // 23: jsonhttp.Value.Cast.type:type_name -> jsonhttp.Value.Cast.Type
-	8,  // 24: jsonhttp.Value.Cast.value:type_name -> jsonhttp.Value
-	8,  // 25: jsonhttp.Value.List.values:type_name -> jsonhttp.Value
-	26, // 26: jsonhttp.Value.Map.values:type_name -> jsonhttp.Value.Map.ValuesEntry
-	2,  // 27: jsonhttp.Value.Util.Now.type:type_name -> jsonhttp.Value.Util.Now.Type
-	3,  // 28: jsonhttp.Value.Util.Random.type:type_name -> jsonhttp.Value.Util.Random.Type
-	25, // 29: jsonhttp.Value.Util.Random.dice:type_name -> jsonhttp.Value.Util.Random.Dice
-	8,  // 30: jsonhttp.Value.Map.ValuesEntry.value:type_name -> jsonhttp.Value
-	8,  // 31: jsonhttp.Action.Gateway.path:type_name -> jsonhttp.Value
-	0,  // 32: jsonhttp.Action.Gateway.methodType:type_name -> jsonhttp.MethodType
-	8,  // 33: jsonhttp.Action.Gateway.timeout:type_name -> jsonhttp.Value
-	9,  // 34: jsonhttp.Action.Gateway.templates:type_name -> jsonhttp.Template
-	9,  // 35: jsonhttp.Action.Gateway.responseTemplates:type_name -> jsonhttp.Template
-	7,  // 36: jsonhttp.Action.Gateway.templateType:type_name -> jsonhttp.Action.TemplateType
-	7,  // 37: jsonhttp.Action.Gateway.responseTemplateType:type_name -> jsonhttp.Action.TemplateType
-	9,  // 38: jsonhttp.Action.Return.templates:type_name -> jsonhttp.Template
-	8,  // 39: jsonhttp.Action.Return.delay:type_name -> jsonhttp.Value
-	7,  // 40: jsonhttp.Action.Return.templateType:type_name -> jsonhttp.Action.TemplateType
-	41, // [41:41] is the sub-list for method output_type
-	41, // [41:41] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	68,  // 0: jsonhttp.Value.null:type_name -> google.protobuf.NullValue
+	50,  // 1: jsonhttp.Value.l:type_name -> jsonhttp.Value.List
+	51,  // 2: jsonhttp.Value.m:type_name -> jsonhttp.Value.Map
+	34,  // 3: jsonhttp.Value.header:type_name -> jsonhttp.Value.Header
+	35,  // 4: jsonhttp.Value.body:type_name -> jsonhttp.Value.Body
+	36,  // 5: jsonhttp.Value.url:type_name -> jsonhttp.Value.Url
+	40,  // 6: jsonhttp.Value.util:type_name -> jsonhttp.Value.Util
+	41,  // 7: jsonhttp.Value.add:type_name -> jsonhttp.Value.Add
+	42,  // 8: jsonhttp.Value.cast:type_name -> jsonhttp.Value.Cast
+	37,  // 9: jsonhttp.Value.request:type_name -> jsonhttp.Value.Request
+	38,  // 10: jsonhttp.Value.cookie:type_name -> jsonhttp.Value.Cookie
+	39,  // 11: jsonhttp.Value.dump:type_name -> jsonhttp.Value.Dump
+	43,  // 12: jsonhttp.Value.jsonParse:type_name -> jsonhttp.Value.JsonParse
+	44,  // 13: jsonhttp.Value.jsonStringify:type_name -> jsonhttp.Value.JsonStringify
+	45,  // 14: jsonhttp.Value.encode:type_name -> jsonhttp.Value.Encode
+	46,  // 15: jsonhttp.Value.decode:type_name -> jsonhttp.Value.Decode
+	47,  // 16: jsonhttp.Value.hash:type_name -> jsonhttp.Value.Hash
+	48,  // 17: jsonhttp.Value.collection:type_name -> jsonhttp.Value.Collection
+	49,  // 18: jsonhttp.Value.item:type_name -> jsonhttp.Value.Item
+	52,  // 19: jsonhttp.Value.upstream:type_name -> jsonhttp.Value.Upstream
+	53,  // 20: jsonhttp.Value.param:type_name -> jsonhttp.Value.Param
+	13,  // 21: jsonhttp.Template.type:type_name -> jsonhttp.Template.Type
+	16,  // 22: jsonhttp.Template.value:type_name -> jsonhttp.Value
+	61,  // 23: jsonhttp.Action.return:type_name -> jsonhttp.Action.Return
+	60,  // 24: jsonhttp.Action.gateway:type_name -> jsonhttp.Action.Gateway
+	62,  // 25: jsonhttp.Action.aggregate:type_name -> jsonhttp.Action.Aggregate
+	64,  // 26: jsonhttp.Upstreams.targets:type_name -> jsonhttp.Upstreams.Target
+	15,  // 27: jsonhttp.Upstreams.strategy:type_name -> jsonhttp.Upstreams.Strategy
+	61,  // 28: jsonhttp.CircuitBreaker.fallback:type_name -> jsonhttp.Action.Return
+	16,  // 29: jsonhttp.Cache.key:type_name -> jsonhttp.Value
+	65,  // 30: jsonhttp.Match.headers:type_name -> jsonhttp.Match.HeadersEntry
+	66,  // 31: jsonhttp.Match.queries:type_name -> jsonhttp.Match.QueriesEntry
+	67,  // 32: jsonhttp.Match.body:type_name -> jsonhttp.Match.BodyEntry
+	69,  // 33: jsonhttp.Schema.inline:type_name -> google.protobuf.Struct
+	27,  // 34: jsonhttp.Validation.body:type_name -> jsonhttp.Schema
+	27,  // 35: jsonhttp.Validation.query:type_name -> jsonhttp.Schema
+	27,  // 36: jsonhttp.Validation.header:type_name -> jsonhttp.Schema
+	28,  // 37: jsonhttp.Validation.openapi:type_name -> jsonhttp.OpenAPIOperation
+	0,   // 38: jsonhttp.Handler.methodType:type_name -> jsonhttp.MethodType
+	18,  // 39: jsonhttp.Handler.action:type_name -> jsonhttp.Action
+	26,  // 40: jsonhttp.Handler.match:type_name -> jsonhttp.Match
+	29,  // 41: jsonhttp.Handler.validate:type_name -> jsonhttp.Validation
+	32,  // 42: jsonhttp.Server.handlers:type_name -> jsonhttp.Handler
+	19,  // 43: jsonhttp.Server.client:type_name -> jsonhttp.Client
+	30,  // 44: jsonhttp.Server.hars:type_name -> jsonhttp.Har
+	1,   // 45: jsonhttp.Value.Url.part:type_name -> jsonhttp.Value.Url.Part
+	55,  // 46: jsonhttp.Value.Url.query:type_name -> jsonhttp.Value.Url.Query
+	54,  // 47: jsonhttp.Value.Url.path:type_name -> jsonhttp.Value.Url.Path
+	2,   // 48: jsonhttp.Value.Request.part:type_name -> jsonhttp.Value.Request.Part
+	3,   // 49: jsonhttp.Value.Dump.target:type_name -> jsonhttp.Value.Dump.Target
+	56,  // 50: jsonhttp.Value.Util.now:type_name -> jsonhttp.Value.Util.Now
+	57,  // 51: jsonhttp.Value.Util.random:type_name -> jsonhttp.Value.Util.Random
+	6,   // 52: jsonhttp.Value.Add.type:type_name -> jsonhttp.Value.Add.Type
+	16,  // 53: jsonhttp.Value.Add.values:type_name -> jsonhttp.Value
+	7,   // 54: jsonhttp.Value.Cast.type:type_name -> jsonhttp.Value.Cast.Type
+	16,  // 55: jsonhttp.Value.Cast.value:type_name -> jsonhttp.Value
+	16,  // 56: jsonhttp.Value.JsonParse.value:type_name -> jsonhttp.Value
+	16,  // 57: jsonhttp.Value.JsonStringify.value:type_name -> jsonhttp.Value
+	8,   // 58: jsonhttp.Value.Encode.type:type_name -> jsonhttp.Value.Encode.Type
+	16,  // 59: jsonhttp.Value.Encode.value:type_name -> jsonhttp.Value
+	8,   // 60: jsonhttp.Value.Decode.type:type_name -> jsonhttp.Value.Encode.Type
+	16,  // 61: jsonhttp.Value.Decode.value:type_name -> jsonhttp.Value
+	9,   // 62: jsonhttp.Value.Hash.type:type_name -> jsonhttp.Value.Hash.Type
+	16,  // 63: jsonhttp.Value.Hash.value:type_name -> jsonhttp.Value
+	16,  // 64: jsonhttp.Value.Hash.key:type_name -> jsonhttp.Value
+	8,   // 65: jsonhttp.Value.Hash.encoding:type_name -> jsonhttp.Value.Encode.Type
+	10,  // 66: jsonhttp.Value.Collection.type:type_name -> jsonhttp.Value.Collection.Type
+	16,  // 67: jsonhttp.Value.Collection.value:type_name -> jsonhttp.Value
+	16,  // 68: jsonhttp.Value.Collection.args:type_name -> jsonhttp.Value
+	16,  // 69: jsonhttp.Value.Collection.item:type_name -> jsonhttp.Value
+	11,  // 70: jsonhttp.Value.Item.part:type_name -> jsonhttp.Value.Item.Part
+	16,  // 71: jsonhttp.Value.List.values:type_name -> jsonhttp.Value
+	59,  // 72: jsonhttp.Value.Map.values:type_name -> jsonhttp.Value.Map.ValuesEntry
+	12,  // 73: jsonhttp.Value.Upstream.part:type_name -> jsonhttp.Value.Upstream.Part
+	4,   // 74: jsonhttp.Value.Util.Now.type:type_name -> jsonhttp.Value.Util.Now.Type
+	5,   // 75: jsonhttp.Value.Util.Random.type:type_name -> jsonhttp.Value.Util.Random.Type
+	58,  // 76: jsonhttp.Value.Util.Random.dice:type_name -> jsonhttp.Value.Util.Random.Dice
+	16,  // 77: jsonhttp.Value.Map.ValuesEntry.value:type_name -> jsonhttp.Value
+	16,  // 78: jsonhttp.Action.Gateway.path:type_name -> jsonhttp.Value
+	0,   // 79: jsonhttp.Action.Gateway.methodType:type_name -> jsonhttp.MethodType
+	16,  // 80: jsonhttp.Action.Gateway.timeout:type_name -> jsonhttp.Value
+	17,  // 81: jsonhttp.Action.Gateway.templates:type_name -> jsonhttp.Template
+	17,  // 82: jsonhttp.Action.Gateway.responseTemplates:type_name -> jsonhttp.Template
+	14,  // 83: jsonhttp.Action.Gateway.templateType:type_name -> jsonhttp.Action.TemplateType
+	14,  // 84: jsonhttp.Action.Gateway.responseTemplateType:type_name -> jsonhttp.Action.TemplateType
+	19,  // 85: jsonhttp.Action.Gateway.client:type_name -> jsonhttp.Client
+	20,  // 86: jsonhttp.Action.Gateway.retry:type_name -> jsonhttp.Retry
+	21,  // 87: jsonhttp.Action.Gateway.upstreams:type_name -> jsonhttp.Upstreams
+	22,  // 88: jsonhttp.Action.Gateway.circuitBreaker:type_name -> jsonhttp.CircuitBreaker
+	23,  // 89: jsonhttp.Action.Gateway.mirror:type_name -> jsonhttp.Mirror
+	24,  // 90: jsonhttp.Action.Gateway.cache:type_name -> jsonhttp.Cache
+	25,  // 91: jsonhttp.Action.Gateway.errorStatus:type_name -> jsonhttp.ErrorStatus
+	31,  // 92: jsonhttp.Action.Gateway.record:type_name -> jsonhttp.Record
+	17,  // 93: jsonhttp.Action.Return.templates:type_name -> jsonhttp.Template
+	16,  // 94: jsonhttp.Action.Return.delay:type_name -> jsonhttp.Value
+	14,  // 95: jsonhttp.Action.Return.templateType:type_name -> jsonhttp.Action.TemplateType
+	16,  // 96: jsonhttp.Action.Return.statusValue:type_name -> jsonhttp.Value
+	63,  // 97: jsonhttp.Action.Aggregate.calls:type_name -> jsonhttp.Action.Aggregate.Call
+	61,  // 98: jsonhttp.Action.Aggregate.return:type_name -> jsonhttp.Action.Return
+	60,  // 99: jsonhttp.Action.Aggregate.Call.gateway:type_name -> jsonhttp.Action.Gateway
+	16,  // 100: jsonhttp.Match.BodyEntry.value:type_name -> jsonhttp.Value
+	101, // [101:101] is the sub-list for method output_type
+	101, // [101:101] is the sub-list for method input_type
+	101, // [101:101] is the sub-list for extension type_name
+	101, // [101:101] is the sub-list for extension extendee
+	0,   // [0:101] is the sub-list for field type_name
 }
 
 func init() { file_origin_proto_init() }
@@ -2142,8 +5631,80 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Template); i {
+		file_origin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Template); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Client); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Retry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upstreams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mirror); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2154,8 +5715,8 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Action); i {
+		file_origin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cache); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2166,7 +5727,91 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenAPIOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Validation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Har); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Handler); i {
 			case 0:
 				return &v.state
@@ -2178,7 +5823,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Server); i {
 			case 0:
 				return &v.state
@@ -2190,7 +5835,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Header); i {
 			case 0:
 				return &v.state
@@ -2202,7 +5847,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Body); i {
 			case 0:
 				return &v.state
@@ -2214,7 +5859,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Url); i {
 			case 0:
 				return &v.state
@@ -2226,7 +5871,43 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Cookie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Dump); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Util); i {
 			case 0:
 				return &v.state
@@ -2238,7 +5919,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Add); i {
 			case 0:
 				return &v.state
@@ -2250,7 +5931,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Cast); i {
 			case 0:
 				return &v.state
@@ -2262,7 +5943,91 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_JsonParse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_JsonStringify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Encode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Decode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Hash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Collection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_List); i {
 			case 0:
 				return &v.state
@@ -2274,7 +6039,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Map); i {
 			case 0:
 				return &v.state
@@ -2286,7 +6051,31 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Upstream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value_Param); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Url_Path); i {
 			case 0:
 				return &v.state
@@ -2298,7 +6087,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Url_Query); i {
 			case 0:
 				return &v.state
@@ -2310,7 +6099,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Util_Now); i {
 			case 0:
 				return &v.state
@@ -2322,7 +6111,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Util_Random); i {
 			case 0:
 				return &v.state
@@ -2334,7 +6123,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Value_Util_Random_Dice); i {
 			case 0:
 				return &v.state
@@ -2346,7 +6135,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Action_Gateway); i {
 			case 0:
 				return &v.state
@@ -2358,7 +6147,7 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
-		file_origin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_origin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Action_Return); i {
 			case 0:
 				return &v.state
@@ -2370,6 +6159,42 @@ func file_origin_proto_init() {
 				return nil
 			}
 		}
+		file_origin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action_Aggregate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action_Aggregate_Call); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_origin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upstreams_Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_origin_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Value_Null)(nil),
@@ -2384,21 +6209,40 @@ func file_origin_proto_init() {
 		(*Value_Util_)(nil),
 		(*Value_Add_)(nil),
 		(*Value_Cast_)(nil),
+		(*Value_Format)(nil),
+		(*Value_Expr)(nil),
+		(*Value_Request_)(nil),
+		(*Value_Cookie_)(nil),
+		(*Value_Dump_)(nil),
+		(*Value_JsonParse_)(nil),
+		(*Value_JsonStringify_)(nil),
+		(*Value_Encode_)(nil),
+		(*Value_Decode_)(nil),
+		(*Value_Hash_)(nil),
+		(*Value_Collection_)(nil),
+		(*Value_Item_)(nil),
+		(*Value_Upstream_)(nil),
+		(*Value_Param_)(nil),
 	}
 	file_origin_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*Action_Return_)(nil),
 		(*Action_Gateway_)(nil),
+		(*Action_Aggregate_)(nil),
+	}
+	file_origin_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*Schema_Inline)(nil),
+		(*Schema_File)(nil),
 	}
-	file_origin_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_origin_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*Value_Url_Part_)(nil),
 		(*Value_Url_Query_)(nil),
 		(*Value_Url_Path_)(nil),
 	}
-	file_origin_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_origin_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*Value_Util_Now_)(nil),
 		(*Value_Util_Random_)(nil),
 	}
-	file_origin_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_origin_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*Value_Util_Random_Type_)(nil),
 		(*Value_Util_Random_Dice_)(nil),
 	}
@@ -2407,8 +6251,8 @@ func file_origin_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_origin_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   21,
+			NumEnums:      16,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,26 @@
+package pb
+
+import "github.com/berquerant/jsonhttp/internal/errors"
+
+// ParamBuilder extracts a path parameter of the handler.
+type ParamBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewParamBuilder(param *Value_Param) ParamBuilder {
+	return &paramBuilder{
+		param: param,
+	}
+}
+
+type paramBuilder struct {
+	param *Value_Param
+}
+
+func (s *paramBuilder) Build(r TemplateSource) (*Value, error) {
+	v, ok := r.PathParams()[s.param.GetName()]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "path parameter %s", s.param.GetName())
+	}
+	return NewS(v), nil
+}
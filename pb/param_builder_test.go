@@ -0,0 +1,58 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestParamBuilder(t *testing.T) {
+	src := pb.NewPathParamsTemplateSource(pb.NewTemplateSource(nil, nil, []byte(`{}`)), map[string]string{
+		"id": "10",
+	})
+
+	for _, tc := range []*struct {
+		title string
+		value string
+		want  interface{}
+		isErr bool
+	}{
+		{
+			title: "param",
+			value: `{"param":{"name":"id"}}`,
+			want:  "10",
+		},
+		{
+			title: "format",
+			value: `{"format":"user-${param.id}"}`,
+			want:  "user-10",
+		},
+		{
+			title: "expr",
+			value: `{"expr":"int(param.id) + 1"}`,
+			want:  11,
+		},
+		{
+			title: "not found",
+			value: `{"param":{"name":"name"}}`,
+			isErr: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var v pb.Value
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.value), &v))
+			got, err := handler.NewTemplateValueBuilder().Build(&v, src)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			x, err := pb.NewValueInverter().Invert(got)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, x)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package pb
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+)
+
+// RecordBuilder builds a handler replaying the response to the request.
+type RecordBuilder interface {
+	Build(r *http.Request, requestBody []byte, res UpstreamResponse) (*Handler, error)
+}
+
+func NewRecordBuilder(record *Record, valueConverter ValueConverter) RecordBuilder {
+	return &recordBuilder{
+		record:         record,
+		valueConverter: valueConverter,
+	}
+}
+
+type recordBuilder struct {
+	record         *Record
+	valueConverter ValueConverter
+}
+
+func (s *recordBuilder) Build(r *http.Request, requestBody []byte, res UpstreamResponse) (*Handler, error) {
+	match, err := s.buildMatch(r, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := s.buildReturn(res)
+	if err != nil {
+		return nil, err
+	}
+	methodType := MethodType_ANY
+	if x, ok := MethodType_value[r.Method]; ok {
+		methodType = MethodType(x)
+	}
+	return &Handler{
+		Path:       r.URL.Path,
+		MethodType: methodType,
+		Match:      match,
+		Action: &Action{
+			Action: &Action_Return_{
+				Return: ret,
+			},
+		},
+	}, nil
+}
+
+func (s *recordBuilder) buildMatch(r *http.Request, requestBody []byte) (*Match, error) {
+	m := &Match{}
+	for _, k := range s.record.GetHeaders() {
+		if v := r.Header.Get(k); v != "" {
+			if m.Headers == nil {
+				m.Headers = map[string]string{}
+			}
+			m.Headers[k] = v
+		}
+	}
+	q := r.URL.Query()
+	for _, k := range s.record.GetQueries() {
+		if v := q.Get(k); v != "" {
+			if m.Queries == nil {
+				m.Queries = map[string]string{}
+			}
+			m.Queries[k] = v
+		}
+	}
+	if len(s.record.GetBody()) > 0 {
+		var b interface{}
+		if err := json.Unmarshal(requestBody, &b); err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidValue, "request body is not json %s", requestBody)
+		}
+		for _, k := range s.record.GetBody() {
+			x, ok := util.GetPath(b, strings.Split(k, "."))
+			if !ok {
+				continue
+			}
+			v, err := s.valueConverter.Convert(x)
+			if err != nil {
+				return nil, errors.Wrapf(err, errors.InvalidValue, "convert request body %s", k)
+			}
+			if m.Body == nil {
+				m.Body = map[string]*Value{}
+			}
+			m.Body[k] = v
+		}
+	}
+	if m.Headers == nil && m.Queries == nil && m.Body == nil {
+		return nil, nil
+	}
+	return m, nil
+}
+
+func (s *recordBuilder) buildReturn(res UpstreamResponse) (*Action_Return, error) {
+	ret := &Action_Return{
+		Status: int32(res.Status()),
+	}
+	keys := s.record.GetResponseHeaders()
+	if len(keys) == 0 {
+		keys = []string{"Content-Type"}
+	}
+	headers := map[string]*Value{}
+	for _, k := range keys {
+		xs := res.Header().Values(k)
+		switch len(xs) {
+		case 0:
+			continue
+		case 1:
+			headers[http.CanonicalHeaderKey(k)] = NewS(xs[0])
+		default:
+			vs := make([]*Value, len(xs))
+			for i, x := range xs {
+				vs[i] = NewS(x)
+			}
+			headers[http.CanonicalHeaderKey(k)] = NewL(vs)
+		}
+	}
+	if len(headers) > 0 {
+		ret.Templates = append(ret.Templates, &Template{
+			Type:  Template_HEADER,
+			Value: NewM(headers),
+		})
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(res.Body(), &body); err == nil && body != nil {
+		v, err := s.valueConverter.Convert(body)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InvalidValue, "convert response body")
+		}
+		ret.Templates = append(ret.Templates, &Template{
+			Type:  Template_BODY,
+			Value: v,
+		})
+		return ret, nil
+	}
+	if !utf8.Valid(res.Body()) {
+		ret.RawBytes = res.Body()
+		return ret, nil
+	}
+	ret.Raw = string(res.Body())
+	return ret, nil
+}
@@ -0,0 +1,113 @@
+package pb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRecordBuilder(t *testing.T) {
+	jsonHeader := http.Header{}
+	jsonHeader.Set("Content-Type", "application/json")
+	jsonHeader.Set("X-Total", "2")
+	textHeader := http.Header{}
+	textHeader.Set("Content-Type", "text/plain")
+	textHeader.Add("Set-Cookie", "a=1")
+	textHeader.Add("Set-Cookie", "b=2")
+
+	for _, tc := range []*struct {
+		title       string
+		record      string
+		method      string
+		target      string
+		header      map[string]string
+		requestBody string
+		res         pb.UpstreamResponse
+		want        string
+		isErr       bool
+	}{
+		{
+			title:  "json",
+			record: `{"file":"r.json"}`,
+			method: http.MethodGet,
+			target: "/users?id=1",
+			res:    pb.NewUpstreamResponse(200, &jsonHeader, []byte(`{"name":"alice","tags":["a"]}`)),
+			want: `{"path":"/users","action":{"return":{"status":200,"templates":[
+{"type":"HEADER","value":{"m":{"values":{"Content-Type":{"s":"application/json"}}}}},
+{"value":{"m":{"values":{"name":{"s":"alice"},"tags":{"l":{"values":[{"s":"a"}]}}}}}}
+]}}}`,
+		},
+		{
+			title:       "match",
+			record:      `{"file":"r.json","headers":["accept","authorization"],"queries":["id","page"],"body":["user.name","user.age"],"responseHeaders":["x-total"]}`,
+			method:      http.MethodPost,
+			target:      "/users?id=1&sort=asc",
+			header:      map[string]string{"Accept": "application/json"},
+			requestBody: `{"user":{"name":"alice"}}`,
+			res:         pb.NewUpstreamResponse(201, &jsonHeader, []byte(`{}`)),
+			want: `{"path":"/users","methodType":"POST","match":{
+"headers":{"accept":"application/json"},
+"queries":{"id":"1"},
+"body":{"user.name":{"s":"alice"}}
+},"action":{"return":{"status":201,"templates":[
+{"type":"HEADER","value":{"m":{"values":{"X-Total":{"s":"2"}}}}},
+{"value":{"m":{}}}
+]}}}`,
+		},
+		{
+			title:  "raw",
+			record: `{"file":"r.json","responseHeaders":["Content-Type","Set-Cookie"]}`,
+			method: "PROPFIND",
+			target: "/files",
+			res:    pb.NewUpstreamResponse(207, &textHeader, []byte(`ok`)),
+			want: `{"path":"/files","methodType":"ANY","action":{"return":{"status":207,"raw":"ok","templates":[
+{"type":"HEADER","value":{"m":{"values":{
+"Content-Type":{"s":"text/plain"},
+"Set-Cookie":{"l":{"values":[{"s":"a=1"},{"s":"b=2"}]}}
+}}}}
+]}}}`,
+		},
+		{
+			title:  "request body not json",
+			record: `{"file":"r.json","body":["name"]}`,
+			method: http.MethodPost,
+			target: "/users",
+			res:    pb.NewUpstreamResponse(200, &jsonHeader, []byte(`{}`)),
+			isErr:  true,
+		},
+		{
+			title:  "response body not utf8",
+			record: `{"file":"r.json"}`,
+			method: http.MethodGet,
+			target: "/image",
+			res:    pb.NewUpstreamResponse(200, &textHeader, []byte{0xff, 0xfe}),
+			want: `{"path":"/image","action":{"return":{"status":200,"templates":[
+{"type":"HEADER","value":{"m":{"values":{"Content-Type":{"s":"text/plain"}}}}}
+],"rawBytes":"//4="}}}`,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var record pb.Record
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.record), &record))
+			r := httptest.NewRequest(tc.method, tc.target, nil)
+			for k, v := range tc.header {
+				r.Header.Set(k, v)
+			}
+			got, err := pb.NewRecordBuilder(&record, pb.NewValueConverter()).Build(r, []byte(tc.requestBody), tc.res)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			var want pb.Handler
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.want), &want))
+			assert.Equal(t, "", cmp.Diff(&want, got, protocmp.Transform()))
+		})
+	}
+}
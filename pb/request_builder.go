@@ -0,0 +1,71 @@
+package pb
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+)
+
+// RequestBuilder extracts metadata of http request.
+type RequestBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewRequestBuilder(request *Value_Request) RequestBuilder {
+	return &requestBuilder{
+		request: request,
+	}
+}
+
+type requestBuilder struct {
+	request *Value_Request
+}
+
+func (s *requestBuilder) Build(r TemplateSource) (*Value, error) {
+	switch s.request.GetPart() {
+	case Value_Request_METHOD:
+		return NewS(r.Method()), nil
+	case Value_Request_REMOTE_ADDR:
+		return NewS(r.RemoteAddr()), nil
+	case Value_Request_REMOTE_HOST:
+		host, _, err := net.SplitHostPort(r.RemoteAddr())
+		if err != nil {
+			return NewS(r.RemoteAddr()), nil
+		}
+		return NewS(host), nil
+	case Value_Request_PROTO:
+		return NewS(r.Proto()), nil
+	case Value_Request_CONTENT_LENGTH:
+		return NewN(float64(r.ContentLength())), nil
+	case Value_Request_REQUEST_ID:
+		return NewS(r.RequestID()), nil
+	}
+	return nil, errors.Newf(errors.UnknownError, "request builder %s", s.request.GetPart())
+}
+
+// CookieBuilder extracts a cookie from http request headers.
+type CookieBuilder interface {
+	Build(header *http.Header) (*Value, error)
+}
+
+func NewCookieBuilder(cookie *Value_Cookie) CookieBuilder {
+	return &cookieBuilder{
+		cookie: cookie,
+	}
+}
+
+type cookieBuilder struct {
+	cookie *Value_Cookie
+}
+
+func (s *cookieBuilder) Build(header *http.Header) (*Value, error) {
+	if header == nil {
+		return nil, errors.New(errors.InvalidSettings, "header is nil")
+	}
+	c, err := (&http.Request{Header: *header}).Cookie(s.cookie.GetKey())
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.NotFound, "%s is not in cookies", s.cookie.GetKey())
+	}
+	return NewS(c.Value), nil
+}
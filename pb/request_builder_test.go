@@ -0,0 +1,114 @@
+package pb_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestBuilder(t *testing.T) {
+	r, err := http.NewRequest(http.MethodPost, "http://localhost/a", nil)
+	assert.Nil(t, err)
+	r.RemoteAddr = "192.168.0.1:61000"
+	r.ContentLength = 12
+	src := pb.NewRequestTemplateSource(r, []byte(`{}`), "reqid")
+
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			part  pb.Value_Request_Part
+			want  interface{}
+		}{
+			{
+				title: "method",
+				part:  pb.Value_Request_METHOD,
+				want:  "POST",
+			},
+			{
+				title: "remote addr",
+				part:  pb.Value_Request_REMOTE_ADDR,
+				want:  "192.168.0.1:61000",
+			},
+			{
+				title: "remote host",
+				part:  pb.Value_Request_REMOTE_HOST,
+				want:  "192.168.0.1",
+			},
+			{
+				title: "proto",
+				part:  pb.Value_Request_PROTO,
+				want:  "HTTP/1.1",
+			},
+			{
+				title: "content length",
+				part:  pb.Value_Request_CONTENT_LENGTH,
+				want:  12,
+			},
+			{
+				title: "request id",
+				part:  pb.Value_Request_REQUEST_ID,
+				want:  "reqid",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewRequestBuilder(&pb.Value_Request{
+					Part: tc.part,
+				}).Build(src)
+				assert.Nil(t, err)
+				v, err := pb.NewValueInverter().Invert(got)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, v)
+			})
+		}
+	})
+}
+
+func TestCookieBuilder(t *testing.T) {
+	t.Run("Build", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			key   string
+			h     *http.Header
+			want  string
+		}{
+			{
+				title: "no headers",
+				key:   "sid",
+			},
+			{
+				title: "no cookies",
+				key:   "sid",
+				h:     &http.Header{},
+			},
+			{
+				title: "cannot hit",
+				key:   "sid",
+				h: &http.Header{
+					"Cookie": []string{"lang=ja"},
+				},
+			},
+			{
+				title: "hit",
+				key:   "sid",
+				h: &http.Header{
+					"Cookie": []string{"lang=ja; sid=xyz"},
+				},
+				want: "xyz",
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				got, err := pb.NewCookieBuilder(&pb.Value_Cookie{
+					Key: tc.key,
+				}).Build(tc.h)
+				if tc.want == "" {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, got.GetS())
+			})
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package pb
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+)
+
+// SetCookieBuilder builds cookies for Set-Cookie headers.
+//
+// value is a Map of a cookie or a List of them.
+// The keys of a cookie are name, value, path, domain, expires, maxAge, httpOnly, secure and sameSite.
+// expires is unix time in seconds or a string of http.TimeFormat,
+// sameSite is one of Lax, Strict and None.
+type SetCookieBuilder interface {
+	Build(value *Value) ([]*http.Cookie, error)
+}
+
+func NewSetCookieBuilder(valueCaster ValueCaster) SetCookieBuilder {
+	return &setCookieBuilder{
+		valueCaster: valueCaster,
+	}
+}
+
+type setCookieBuilder struct {
+	valueCaster ValueCaster
+}
+
+func (s *setCookieBuilder) Build(value *Value) ([]*http.Cookie, error) {
+	if value.GetM() != nil {
+		c, err := s.build(value.GetM())
+		if err != nil {
+			return nil, err
+		}
+		return []*http.Cookie{c}, nil
+	}
+	if value.GetL() == nil {
+		return nil, errors.Newf(errors.InvalidValue, "cookie is neither map nor list %s", util.JSON(value))
+	}
+	cs := make([]*http.Cookie, len(value.GetL().GetValues()))
+	for i, v := range value.GetL().GetValues() {
+		if v.GetM() == nil {
+			return nil, errors.Newf(errors.InvalidValue, "cookie %d is not map %s", i, util.JSON(v))
+		}
+		c, err := s.build(v.GetM())
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidValue, "cookie %d", i)
+		}
+		cs[i] = c
+	}
+	return cs, nil
+}
+
+func (s *setCookieBuilder) build(m *Value_Map) (*http.Cookie, error) {
+	var c http.Cookie
+	for k, v := range m.GetValues() {
+		var err error
+		switch k {
+		case "name":
+			c.Name, err = s.valueCaster.String(v)
+		case "value":
+			c.Value, err = s.valueCaster.String(v)
+		case "path":
+			c.Path, err = s.valueCaster.String(v)
+		case "domain":
+			c.Domain, err = s.valueCaster.String(v)
+		case "expires":
+			c.Expires, err = s.expires(v)
+		case "maxAge":
+			c.MaxAge, err = s.valueCaster.Int(v)
+		case "httpOnly":
+			c.HttpOnly, err = s.valueCaster.Bool(v)
+		case "secure":
+			c.Secure, err = s.valueCaster.Bool(v)
+		case "sameSite":
+			c.SameSite, err = s.sameSite(v)
+		default:
+			err = errors.Newf(errors.InvalidValue, "unknown cookie key %s", k)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidValue, "cookie %s", k)
+		}
+	}
+	if c.String() == "" {
+		return nil, errors.Newf(errors.InvalidValue, "invalid cookie name %q", c.Name)
+	}
+	return &c, nil
+}
+
+func (s *setCookieBuilder) expires(v *Value) (time.Time, error) {
+	if x, ok := v.GetValue().(*Value_S); ok {
+		t, err := time.Parse(http.TimeFormat, x.S)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, errors.InvalidValue, "cannot parse expires %s", x.S)
+		}
+		return t, nil
+	}
+	d, err := s.valueCaster.Int(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(d), 0).UTC(), nil
+}
+
+func (s *setCookieBuilder) sameSite(v *Value) (http.SameSite, error) {
+	x, err := s.valueCaster.String(v)
+	if err != nil {
+		return 0, err
+	}
+	switch strings.ToLower(x) {
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	}
+	return 0, errors.Newf(errors.InvalidValue, "unknown sameSite %s", x)
+}
@@ -0,0 +1,98 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestSetCookieBuilder(t *testing.T) {
+	for _, tc := range []*struct {
+		title string
+		value string
+		want  []string
+		isErr bool
+	}{
+		{
+			title: "minimal",
+			value: `{"m":{"values":{"name":{"s":"sid"},"value":{"s":"abc"}}}}`,
+			want:  []string{"sid=abc"},
+		},
+		{
+			title: "full",
+			value: `{"m":{"values":{
+"name":{"s":"sid"},
+"value":{"n":1},
+"path":{"s":"/"},
+"domain":{"s":"example.com"},
+"expires":{"n":0},
+"maxAge":{"n":60},
+"httpOnly":{"b":true},
+"secure":{"b":true},
+"sameSite":{"s":"strict"}
+}}}`,
+			want: []string{"sid=1; Path=/; Domain=example.com; Expires=Thu, 01 Jan 1970 00:00:00 GMT; Max-Age=60; HttpOnly; Secure; SameSite=Strict"},
+		},
+		{
+			title: "expires by date",
+			value: `{"m":{"values":{"name":{"s":"a"},"expires":{"s":"Wed, 21 Oct 2015 07:28:00 GMT"}}}}`,
+			want:  []string{"a=; Expires=Wed, 21 Oct 2015 07:28:00 GMT"},
+		},
+		{
+			title: "list",
+			value: `{"l":{"values":[
+{"m":{"values":{"name":{"s":"a"},"value":{"s":"1"}}}},
+{"m":{"values":{"name":{"s":"b"},"value":{"s":"2"},"sameSite":{"s":"Lax"}}}}
+]}}`,
+			want: []string{"a=1", "b=2; SameSite=Lax"},
+		},
+		{
+			title: "no name",
+			value: `{"m":{"values":{"value":{"s":"abc"}}}}`,
+			isErr: true,
+		},
+		{
+			title: "unknown key",
+			value: `{"m":{"values":{"name":{"s":"a"},"x":{"s":"abc"}}}}`,
+			isErr: true,
+		},
+		{
+			title: "invalid expires",
+			value: `{"m":{"values":{"name":{"s":"a"},"expires":{"s":"tomorrow"}}}}`,
+			isErr: true,
+		},
+		{
+			title: "invalid sameSite",
+			value: `{"m":{"values":{"name":{"s":"a"},"sameSite":{"s":"x"}}}}`,
+			isErr: true,
+		},
+		{
+			title: "not map in list",
+			value: `{"l":{"values":[{"s":"a"}]}}`,
+			isErr: true,
+		},
+		{
+			title: "string",
+			value: `{"s":"a=1"}`,
+			isErr: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var v pb.Value
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.value), &v))
+			got, err := pb.NewSetCookieBuilder(pb.NewValueCaster()).Build(&v)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			xs := make([]string, len(got))
+			for i, c := range got {
+				xs[i] = c.String()
+			}
+			assert.Equal(t, tc.want, xs)
+		})
+	}
+}
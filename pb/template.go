@@ -13,12 +13,29 @@ type TemplateSource interface {
 	Body() []byte
 	URL() *url.URL
 	Header() *http.Header
+	// Method returns the request method, empty if not a request.
+	Method() string
+	// RemoteAddr returns host:port of the client, empty if not a request.
+	RemoteAddr() string
+	Proto() string
+	ContentLength() int64
+	// RequestID returns X-Request-Id of the response, empty if not a request.
+	RequestID() string
+	// Upstreams returns the responses of the calls of Aggregate by name.
+	Upstreams() map[string]UpstreamResponse
+	// PathParams returns the path parameters of the handler by name.
+	PathParams() map[string]string
 }
 
 type templateSource struct {
-	body   []byte
-	url    *url.URL
-	header *http.Header
+	body          []byte
+	url           *url.URL
+	header        *http.Header
+	method        string
+	remoteAddr    string
+	proto         string
+	contentLength int64
+	requestID     string
 }
 
 func NewTemplateSource(url *url.URL, header *http.Header, body []byte) TemplateSource {
@@ -29,50 +46,165 @@ func NewTemplateSource(url *url.URL, header *http.Header, body []byte) TemplateS
 	}
 }
 
+// NewRequestTemplateSource returns a TemplateSource with the metadata of the request.
+// body is the already read body of the request.
+func NewRequestTemplateSource(r *http.Request, body []byte, requestID string) TemplateSource {
+	return &templateSource{
+		body:          body,
+		url:           r.URL,
+		header:        &r.Header,
+		method:        r.Method,
+		remoteAddr:    r.RemoteAddr,
+		proto:         r.Proto,
+		contentLength: r.ContentLength,
+		requestID:     requestID,
+	}
+}
+
 func (s *templateSource) Body() []byte         { return s.body }
 func (s *templateSource) URL() *url.URL        { return s.url }
 func (s *templateSource) Header() *http.Header { return s.header }
+func (s *templateSource) Method() string       { return s.method }
+func (s *templateSource) RemoteAddr() string   { return s.remoteAddr }
+func (s *templateSource) Proto() string        { return s.proto }
+func (s *templateSource) ContentLength() int64 { return s.contentLength }
+func (s *templateSource) RequestID() string    { return s.requestID }
+
+func (*templateSource) Upstreams() map[string]UpstreamResponse { return nil }
+func (*templateSource) PathParams() map[string]string          { return nil }
+
+// NewPathParamsTemplateSource returns a TemplateSource with the path parameters.
+func NewPathParamsTemplateSource(src TemplateSource, params map[string]string) TemplateSource {
+	return &pathParamsTemplateSource{
+		TemplateSource: src,
+		params:         params,
+	}
+}
+
+type pathParamsTemplateSource struct {
+	TemplateSource
+	params map[string]string
+}
+
+func (s *pathParamsTemplateSource) PathParams() map[string]string { return s.params }
 
 // TemplatesBuilder extracts and builds elements from http request.
 type TemplatesBuilder interface {
 	Add(t *Template, r TemplateSource) error
-	Headers() map[string]string
+	Headers() map[string][]string
 	Body() map[string]interface{}
+	// Edit applies SET, DELETE, RENAME, DELETE_HEADER and COOKIE templates in order.
+	Edit(body map[string]interface{}, headers map[string][]string) error
 }
 
-func NewTemplatesBuilder(templateValueBuilder TemplateValueBuilder, valueInverter ValueInverter) TemplatesBuilder {
+func NewTemplatesBuilder(templateValueBuilder TemplateValueBuilder, valueInverter ValueInverter, setCookieBuilder SetCookieBuilder) TemplatesBuilder {
 	return &templatesBuilder{
 		body:                 map[string]interface{}{},
-		headers:              map[string]string{},
+		headers:              map[string][]string{},
 		templateValueBuilder: templateValueBuilder,
 		valueInverter:        valueInverter,
+		setCookieBuilder:     setCookieBuilder,
 	}
 }
 
 type templatesBuilder struct {
 	body                 map[string]interface{}
-	headers              map[string]string
+	headers              map[string][]string
+	edits                []*templateEdit
 	templateValueBuilder TemplateValueBuilder
 	valueInverter        ValueInverter
+	setCookieBuilder     SetCookieBuilder
+}
+
+type templateEdit struct {
+	t     *Template
+	value interface{}
 }
 
 func (s *templatesBuilder) Body() map[string]interface{} { return s.body }
-func (s *templatesBuilder) Headers() map[string]string   { return s.headers }
+func (s *templatesBuilder) Headers() map[string][]string { return s.headers }
 
 func (s *templatesBuilder) Add(t *Template, r TemplateSource) error {
+	switch t.GetType() {
+	case Template_SET:
+		v, err := s.templateValueBuilder.Build(t.GetValue(), r)
+		if err != nil {
+			return errors.Wrap(err, errors.InvalidArgument, "cannot build templates")
+		}
+		x, err := s.valueInverter.Invert(v)
+		if err != nil {
+			return errors.Wrapf(err, errors.InvalidArgument, "cannot invert %s", util.JSON(v))
+		}
+		s.edits = append(s.edits, &templateEdit{
+			t:     t,
+			value: x,
+		})
+		return nil
+	case Template_COOKIE:
+		v, err := s.templateValueBuilder.Build(t.GetValue(), r)
+		if err != nil {
+			return errors.Wrap(err, errors.InvalidArgument, "cannot build templates")
+		}
+		cs, err := s.setCookieBuilder.Build(v)
+		if err != nil {
+			return errors.Wrap(err, errors.InvalidArgument, "cannot build cookies")
+		}
+		s.edits = append(s.edits, &templateEdit{
+			t:     t,
+			value: cs,
+		})
+		return nil
+	case Template_DELETE, Template_RENAME, Template_DELETE_HEADER:
+		s.edits = append(s.edits, &templateEdit{
+			t: t,
+		})
+		return nil
+	}
 	b := newTemplateBuilder(s.templateValueBuilder, s.valueInverter)
 	if err := b.Build(t, r); err != nil {
 		return errors.Wrap(err, errors.InvalidArgument, "cannot build templates")
 	}
-	util.MergeStringMap(s.headers, b.headers)
+	util.MergeStringsMap(s.headers, b.headers)
 	util.MergeMap(s.body, b.body)
 	return nil
 }
 
+func (s *templatesBuilder) Edit(body map[string]interface{}, headers map[string][]string) error {
+	for _, e := range s.edits {
+		keys := e.t.GetKeys()
+		switch e.t.GetType() {
+		case Template_SET:
+			if err := util.SetPath(body, keys, e.value); err != nil {
+				return errors.Wrapf(err, errors.InvalidArgument, "cannot set %v", keys)
+			}
+		case Template_DELETE:
+			util.DeletePath(body, keys)
+		case Template_RENAME:
+			v, ok := util.GetPath(body, keys)
+			if !ok {
+				continue
+			}
+			util.DeletePath(body, keys)
+			if err := util.SetPath(body, e.t.GetTo(), v); err != nil {
+				return errors.Wrapf(err, errors.InvalidArgument, "cannot rename %v to %v", keys, e.t.GetTo())
+			}
+		case Template_DELETE_HEADER:
+			for _, k := range keys {
+				delete(headers, http.CanonicalHeaderKey(k))
+			}
+		case Template_COOKIE:
+			for _, c := range e.value.([]*http.Cookie) {
+				headers["Set-Cookie"] = append(headers["Set-Cookie"], c.String())
+			}
+		}
+	}
+	return nil
+}
+
 func newTemplateBuilder(templateValueBuilder TemplateValueBuilder, valueInverter ValueInverter) *templateBuilder {
 	return &templateBuilder{
 		body:                 map[string]interface{}{},
-		headers:              map[string]string{},
+		headers:              map[string][]string{},
 		templateValueBuilder: templateValueBuilder,
 		valueInverter:        valueInverter,
 	}
@@ -80,7 +212,7 @@ func newTemplateBuilder(templateValueBuilder TemplateValueBuilder, valueInverter
 
 type templateBuilder struct {
 	body                 map[string]interface{}
-	headers              map[string]string
+	headers              map[string][]string
 	templateValueBuilder TemplateValueBuilder
 	valueInverter        ValueInverter
 }
@@ -121,21 +253,41 @@ func (s *templateBuilder) mapDeep(value *Value) (map[string]interface{}, error)
 	return nil, errors.Newf(errors.InvalidValue, "not map %s", util.JSON(value))
 }
 
-func (*templateBuilder) mapShallow(value *Value) map[string]string {
+func (*templateBuilder) mapShallow(value *Value) map[string][]string {
 	if value.GetM() == nil {
 		return nil
 	}
-	d := map[string]string{}
+	var (
+		d      = map[string][]string{}
+		scalar = func(v *Value) (string, bool) {
+			switch v.GetValue().(type) {
+			case *Value_Null:
+				return "null", true
+			case *Value_B:
+				return fmt.Sprint(v.GetB()), true
+			case *Value_N:
+				return fmt.Sprint(v.GetN()), true
+			case *Value_S:
+				return v.GetS(), true
+			}
+			return "", false
+		}
+	)
 	for k, v := range value.GetM().GetValues() {
-		switch v.GetValue().(type) {
-		case *Value_Null:
-			d[k] = "null"
-		case *Value_B:
-			d[k] = fmt.Sprint(v.GetB())
-		case *Value_N:
-			d[k] = fmt.Sprint(v.GetN())
-		case *Value_S:
-			d[k] = v.GetS()
+		if v.GetL() == nil {
+			if x, ok := scalar(v); ok {
+				d[k] = []string{x}
+			}
+			continue
+		}
+		var xs []string
+		for _, e := range v.GetL().GetValues() {
+			if x, ok := scalar(e); ok {
+				xs = append(xs, x)
+			}
+		}
+		if len(xs) > 0 {
+			d[k] = xs
 		}
 	}
 	return d
@@ -153,24 +305,68 @@ func NewTemplateValueBuilder(
 	urlBF func(*Value_Url) URLBuilder,
 	addBF func(*Value_Add, TemplateValueBuilder) AddBuilder,
 	castBF func(*Value_Cast, TemplateValueBuilder) CastBuilder,
+	formatBF func(string, TemplateValueBuilder) FormatBuilder,
+	exprBF func(string) ExprBuilder,
+	requestBF func(*Value_Request) RequestBuilder,
+	cookieBF func(*Value_Cookie) CookieBuilder,
+	dumpBF func(*Value_Dump) DumpBuilder,
+	jsonParseBF func(*Value_JsonParse, TemplateValueBuilder) JSONParseBuilder,
+	jsonStringifyBF func(*Value_JsonStringify, TemplateValueBuilder) JSONStringifyBuilder,
+	encodeBF func(*Value_Encode, TemplateValueBuilder) EncodeBuilder,
+	decodeBF func(*Value_Decode, TemplateValueBuilder) DecodeBuilder,
+	hashBF func(*Value_Hash, TemplateValueBuilder) HashBuilder,
+	collectionBF func(*Value_Collection, TemplateValueBuilder) CollectionBuilder,
+	itemBF func(*Value_Item) ItemBuilder,
+	upstreamBF func(*Value_Upstream) UpstreamBuilder,
+	paramBF func(*Value_Param) ParamBuilder,
 ) TemplateValueBuilder {
 	return &templateValueBuilder{
-		bodyBF:   bodyBF,
-		utilBF:   utilBF,
-		headerBF: headerBF,
-		urlBF:    urlBF,
-		addBF:    addBF,
-		castBF:   castBF,
+		bodyBF:    bodyBF,
+		utilBF:    utilBF,
+		headerBF:  headerBF,
+		urlBF:     urlBF,
+		addBF:     addBF,
+		castBF:    castBF,
+		formatBF:  formatBF,
+		exprBF:    exprBF,
+		requestBF: requestBF,
+		cookieBF:  cookieBF,
+		dumpBF:    dumpBF,
+
+		jsonParseBF:     jsonParseBF,
+		jsonStringifyBF: jsonStringifyBF,
+		encodeBF:        encodeBF,
+		decodeBF:        decodeBF,
+		hashBF:          hashBF,
+		collectionBF:    collectionBF,
+		itemBF:          itemBF,
+		upstreamBF:      upstreamBF,
+		paramBF:         paramBF,
 	}
 }
 
 type templateValueBuilder struct {
-	bodyBF   func(*Value_Body) BodyBuilder
-	utilBF   func(*Value_Util) UtilBuilder
-	headerBF func(*Value_Header) HeaderBuilder
-	urlBF    func(*Value_Url) URLBuilder
-	addBF    func(*Value_Add, TemplateValueBuilder) AddBuilder
-	castBF   func(*Value_Cast, TemplateValueBuilder) CastBuilder
+	bodyBF    func(*Value_Body) BodyBuilder
+	utilBF    func(*Value_Util) UtilBuilder
+	headerBF  func(*Value_Header) HeaderBuilder
+	urlBF     func(*Value_Url) URLBuilder
+	addBF     func(*Value_Add, TemplateValueBuilder) AddBuilder
+	castBF    func(*Value_Cast, TemplateValueBuilder) CastBuilder
+	formatBF  func(string, TemplateValueBuilder) FormatBuilder
+	exprBF    func(string) ExprBuilder
+	requestBF func(*Value_Request) RequestBuilder
+	cookieBF  func(*Value_Cookie) CookieBuilder
+	dumpBF    func(*Value_Dump) DumpBuilder
+
+	jsonParseBF     func(*Value_JsonParse, TemplateValueBuilder) JSONParseBuilder
+	jsonStringifyBF func(*Value_JsonStringify, TemplateValueBuilder) JSONStringifyBuilder
+	encodeBF        func(*Value_Encode, TemplateValueBuilder) EncodeBuilder
+	decodeBF        func(*Value_Decode, TemplateValueBuilder) DecodeBuilder
+	hashBF          func(*Value_Hash, TemplateValueBuilder) HashBuilder
+	collectionBF    func(*Value_Collection, TemplateValueBuilder) CollectionBuilder
+	itemBF          func(*Value_Item) ItemBuilder
+	upstreamBF      func(*Value_Upstream) UpstreamBuilder
+	paramBF         func(*Value_Param) ParamBuilder
 }
 
 func (s *templateValueBuilder) Build(value *Value, r TemplateSource) (*Value, error) {
@@ -220,6 +416,34 @@ func (s *templateValueBuilder) build(value *Value, r TemplateSource) (*Value, er
 		return s.addBF(value.GetAdd(), s).Build(r)
 	case *Value_Cast_:
 		return s.castBF(value.GetCast(), s).Build(r)
+	case *Value_Format:
+		return s.formatBF(value.GetFormat(), s).Build(r)
+	case *Value_Expr:
+		return s.exprBF(value.GetExpr()).Build(r)
+	case *Value_Request_:
+		return s.requestBF(value.GetRequest()).Build(r)
+	case *Value_Cookie_:
+		return s.cookieBF(value.GetCookie()).Build(r.Header())
+	case *Value_Dump_:
+		return s.dumpBF(value.GetDump()).Build(r)
+	case *Value_JsonParse_:
+		return s.jsonParseBF(value.GetJsonParse(), s).Build(r)
+	case *Value_JsonStringify_:
+		return s.jsonStringifyBF(value.GetJsonStringify(), s).Build(r)
+	case *Value_Encode_:
+		return s.encodeBF(value.GetEncode(), s).Build(r)
+	case *Value_Decode_:
+		return s.decodeBF(value.GetDecode(), s).Build(r)
+	case *Value_Hash_:
+		return s.hashBF(value.GetHash(), s).Build(r)
+	case *Value_Collection_:
+		return s.collectionBF(value.GetCollection(), s).Build(r)
+	case *Value_Item_:
+		return s.itemBF(value.GetItem()).Build(r)
+	case *Value_Upstream_:
+		return s.upstreamBF(value.GetUpstream()).Build(r)
+	case *Value_Param_:
+		return s.paramBF(value.GetParam()).Build(r)
 	}
 	return nil, errors.New(errors.UnknownError, "template value builder")
 }
@@ -0,0 +1,142 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestTemplatesBuilderEdit(t *testing.T) {
+	src := pb.NewTemplateSource(nil, nil, []byte(`{"name":"x"}`))
+	newBody := func() map[string]interface{} {
+		return map[string]interface{}{
+			"id": 1,
+			"user": map[string]interface{}{
+				"name":  "a",
+				"roles": []interface{}{"r1", "r2"},
+			},
+		}
+	}
+	newHeaders := func() map[string][]string {
+		return map[string][]string{
+			"Content-Type": {"application/json"},
+			"X-Secret":     {"s"},
+		}
+	}
+
+	for _, tc := range []*struct {
+		title       string
+		templates   []string
+		wantBody    map[string]interface{}
+		wantHeaders map[string][]string
+		isErr       bool
+	}{
+		{
+			title:       "no edits",
+			templates:   []string{`{"value":{"m":{"values":{"k":{"s":"v"}}}}}`},
+			wantBody:    newBody(),
+			wantHeaders: newHeaders(),
+		},
+		{
+			title: "set",
+			templates: []string{
+				`{"type":"SET","keys":["user","roles","0"],"value":{"body":{"keys":["name"]}}}`,
+				`{"type":"SET","keys":["meta","tags"],"value":{"l":{"values":[{"s":"t"}]}}}`,
+			},
+			wantBody: map[string]interface{}{
+				"id": 1,
+				"user": map[string]interface{}{
+					"name":  "a",
+					"roles": []interface{}{"x", "r2"},
+				},
+				"meta": map[string]interface{}{
+					"tags": []interface{}{"t"},
+				},
+			},
+			wantHeaders: newHeaders(),
+		},
+		{
+			title: "set into scalar",
+			templates: []string{
+				`{"type":"SET","keys":["id","x"],"value":{"n":1}}`,
+			},
+			isErr: true,
+		},
+		{
+			title: "delete",
+			templates: []string{
+				`{"type":"DELETE","keys":["user","roles","-1"]}`,
+				`{"type":"DELETE","keys":["id"]}`,
+				`{"type":"DELETE","keys":["missing","key"]}`,
+			},
+			wantBody: map[string]interface{}{
+				"user": map[string]interface{}{
+					"name":  "a",
+					"roles": []interface{}{"r1"},
+				},
+			},
+			wantHeaders: newHeaders(),
+		},
+		{
+			title: "rename",
+			templates: []string{
+				`{"type":"RENAME","keys":["user","name"],"to":["userName"]}`,
+				`{"type":"RENAME","keys":["missing"],"to":["x"]}`,
+			},
+			wantBody: map[string]interface{}{
+				"id":       1,
+				"userName": "a",
+				"user": map[string]interface{}{
+					"roles": []interface{}{"r1", "r2"},
+				},
+			},
+			wantHeaders: newHeaders(),
+		},
+		{
+			title: "delete header",
+			templates: []string{
+				`{"type":"DELETE_HEADER","keys":["x-secret"]}`,
+			},
+			wantBody: newBody(),
+			wantHeaders: map[string][]string{
+				"Content-Type": {"application/json"},
+			},
+		},
+		{
+			title: "cookie",
+			templates: []string{
+				`{"type":"COOKIE","value":{"m":{"values":{"name":{"s":"a"},"value":{"body":{"keys":["name"]}}}}}}`,
+				`{"type":"COOKIE","value":{"l":{"values":[{"m":{"values":{"name":{"s":"b"},"httpOnly":{"b":true}}}}]}}}`,
+			},
+			wantBody: newBody(),
+			wantHeaders: map[string][]string{
+				"Content-Type": {"application/json"},
+				"X-Secret":     {"s"},
+				"Set-Cookie":   {"a=x", "b=; HttpOnly"},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			b := handler.NewTemplatesBuilder()
+			for _, x := range tc.templates {
+				var tmpl pb.Template
+				assert.Nil(t, protojson.Unmarshal([]byte(x), &tmpl))
+				assert.Nil(t, b.Add(&tmpl, src))
+			}
+			body := newBody()
+			headers := newHeaders()
+			err := b.Edit(body, headers)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "", cmp.Diff(tc.wantBody, body))
+			assert.Equal(t, "", cmp.Diff(tc.wantHeaders, headers))
+		})
+	}
+}
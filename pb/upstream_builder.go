@@ -0,0 +1,96 @@
+package pb
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/util"
+)
+
+// UpstreamResponse is the response of a call of Aggregate.
+type UpstreamResponse interface {
+	Status() int
+	Header() *http.Header
+	Body() []byte
+}
+
+func NewUpstreamResponse(status int, header *http.Header, body []byte) UpstreamResponse {
+	return &upstreamResponse{
+		status: status,
+		header: header,
+		body:   body,
+	}
+}
+
+type upstreamResponse struct {
+	status int
+	header *http.Header
+	body   []byte
+}
+
+func (s *upstreamResponse) Status() int          { return s.status }
+func (s *upstreamResponse) Header() *http.Header { return s.header }
+func (s *upstreamResponse) Body() []byte         { return s.body }
+
+// NewUpstreamTemplateSource returns a TemplateSource with the responses of the calls by name.
+func NewUpstreamTemplateSource(src TemplateSource, upstreams map[string]UpstreamResponse) TemplateSource {
+	return &upstreamTemplateSource{
+		TemplateSource: src,
+		upstreams:      upstreams,
+	}
+}
+
+type upstreamTemplateSource struct {
+	TemplateSource
+	upstreams map[string]UpstreamResponse
+}
+
+func (s *upstreamTemplateSource) Upstreams() map[string]UpstreamResponse { return s.upstreams }
+
+// UpstreamBuilder extracts a value from the response of a call of Aggregate.
+type UpstreamBuilder interface {
+	Build(r TemplateSource) (*Value, error)
+}
+
+func NewUpstreamBuilder(upstream *Value_Upstream, valueConverter ValueConverter) UpstreamBuilder {
+	return &upstreamBuilder{
+		upstream:       upstream,
+		valueConverter: valueConverter,
+	}
+}
+
+type upstreamBuilder struct {
+	upstream       *Value_Upstream
+	valueConverter ValueConverter
+}
+
+func (s *upstreamBuilder) Build(r TemplateSource) (*Value, error) {
+	res, ok := r.Upstreams()[s.upstream.GetName()]
+	if !ok {
+		return nil, errors.Newf(errors.NotFound, "upstream %s", s.upstream.GetName())
+	}
+	keys := s.upstream.GetKeys()
+	switch s.upstream.GetPart() {
+	case Value_Upstream_STATUS:
+		return NewN(float64(res.Status())), nil
+	case Value_Upstream_HEADER:
+		if len(keys) == 0 {
+			return nil, errors.Newf(errors.InvalidSettings, "upstream %s header without key", s.upstream.GetName())
+		}
+		return NewHeaderBuilder(&Value_Header{
+			Key: keys[0],
+		}).Build(res.Header())
+	case Value_Upstream_BODY:
+		var v interface{}
+		if err := json.Unmarshal(res.Body(), &v); err != nil {
+			return nil, errors.Wrapf(err, errors.InvalidArgument, "upstream %s body is not json", s.upstream.GetName())
+		}
+		x, ok := util.GetPath(v, keys)
+		if !ok {
+			return nil, errors.Newf(errors.NotFound, "upstream %s body %v", s.upstream.GetName(), keys)
+		}
+		return s.valueConverter.Convert(x)
+	}
+	return nil, errors.Newf(errors.UnknownError, "upstream builder %s", s.upstream.GetPart())
+}
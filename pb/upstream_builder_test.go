@@ -0,0 +1,114 @@
+package pb_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestUpstreamBuilder(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Total", "2")
+	src := pb.NewUpstreamTemplateSource(pb.NewTemplateSource(nil, nil, []byte(`{}`)), map[string]pb.UpstreamResponse{
+		"users": pb.NewUpstreamResponse(200, &header, []byte(`{"items":[{"name":"a"},{"name":"b"}]}`)),
+		"text":  pb.NewUpstreamResponse(503, &http.Header{}, []byte(`unavailable`)),
+	})
+
+	for _, tc := range []*struct {
+		title string
+		value string
+		src   pb.TemplateSource
+		want  interface{}
+		isErr bool
+	}{
+		{
+			title: "body",
+			value: `{"upstream":{"name":"users","keys":["items","-1","name"]}}`,
+			want:  "b",
+		},
+		{
+			title: "whole body",
+			value: `{"upstream":{"name":"users"}}`,
+			want: map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"name": "a"},
+					map[string]interface{}{"name": "b"},
+				},
+			},
+		},
+		{
+			title: "body not found",
+			value: `{"upstream":{"name":"users","keys":["total"]}}`,
+			isErr: true,
+		},
+		{
+			title: "body not json",
+			value: `{"upstream":{"name":"text"}}`,
+			isErr: true,
+		},
+		{
+			title: "status",
+			value: `{"upstream":{"name":"text","part":"STATUS"}}`,
+			want:  503,
+		},
+		{
+			title: "header",
+			value: `{"upstream":{"name":"users","part":"HEADER","keys":["x-total"]}}`,
+			want:  "2",
+		},
+		{
+			title: "header without key",
+			value: `{"upstream":{"name":"users","part":"HEADER"}}`,
+			isErr: true,
+		},
+		{
+			title: "unknown name",
+			value: `{"upstream":{"name":"orders"}}`,
+			isErr: true,
+		},
+		{
+			title: "no upstreams",
+			value: `{"upstream":{"name":"users"}}`,
+			src:   pb.NewTemplateSource(nil, nil, []byte(`{}`)),
+			isErr: true,
+		},
+		{
+			title: "format",
+			value: `{"format":"${upstream.users.items.0.name}"}`,
+			want:  "a",
+		},
+		{
+			title: "expr",
+			value: `{"expr":"upstream.text.status == 503 ? len(upstream.users.body.items) : 0"}`,
+			want:  2,
+		},
+		{
+			title: "in collection item",
+			value: `{"collection":{"type":"MAP","value":{"l":{"values":[{"n":1}]}},"item":{"upstream":{"name":"text","part":"STATUS"}}}}`,
+			want:  []interface{}{503},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var v pb.Value
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.value), &v))
+			s := tc.src
+			if s == nil {
+				s = src
+			}
+			got, err := handler.NewTemplateValueBuilder().Build(&v, s)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			x, err := pb.NewValueInverter().Invert(got)
+			assert.Nil(t, err)
+			assert.Equal(t, "", cmp.Diff(tc.want, x))
+		})
+	}
+}
@@ -41,6 +41,12 @@ func (s *urlBuilder) buildQuery(u *url.URL) (*Value, error) {
 		x = s.urlValue.GetQuery()
 		q = u.Query()
 	)
+	if x.GetAll() {
+		if vs, ok := q[x.GetKey()]; ok && len(vs) > 0 {
+			return newStringList(vs), nil
+		}
+		return nil, errors.Newf(errors.NotFound, "%s not in query %s", x.GetKey(), q)
+	}
 	if v := q.Get(x.GetKey()); v != "" {
 		return NewS(v), nil
 	}
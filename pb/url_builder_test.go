@@ -56,6 +56,51 @@ func TestURLBuilder(t *testing.T) {
 			})
 		}
 	})
+	t.Run("BuildQueryAll", func(t *testing.T) {
+		for _, tc := range []*struct {
+			title string
+			url   string
+			want  []string
+		}{
+			{
+				title: "no hits",
+				url:   "https://example.com?x=1",
+			},
+			{
+				title: "a value",
+				url:   "https://example.com?tag=a",
+				want:  []string{"a"},
+			},
+			{
+				title: "values",
+				url:   "https://example.com?tag=a&x=1&tag=b",
+				want:  []string{"a", "b"},
+			},
+		} {
+			t.Run(tc.title, func(t *testing.T) {
+				u, err := url.Parse(tc.url)
+				assert.Nil(t, err)
+				got, err := pb.NewURLBuilder(&pb.Value_Url{
+					Value: &pb.Value_Url_Query_{
+						Query: &pb.Value_Url_Query{
+							Key: "tag",
+							All: true,
+						},
+					},
+				}).Build(u)
+				if tc.want == nil {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				xs := []string{}
+				for _, x := range got.GetL().GetValues() {
+					xs = append(xs, x.GetS())
+				}
+				assert.Equal(t, tc.want, xs)
+			})
+		}
+	})
 	t.Run("BuildPath", func(t *testing.T) {
 		for _, tc := range []*struct {
 			title string
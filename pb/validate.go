@@ -0,0 +1,233 @@
+package pb
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/expr"
+	"github.com/berquerant/jsonhttp/internal/util"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validate checks the settings that can be verified before serving,
+// e.g. syntax of formats and expressions.
+func Validate(m proto.Message) error {
+	return validateMessage("", m.ProtoReflect())
+}
+
+func validateMessage(path string, m protoreflect.Message) error {
+	if err := validateSelf(m.Interface()); err != nil {
+		return errors.Wrapf(err, errors.InvalidSettings, "at %s", path)
+	}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			return true
+		}
+		p := fmt.Sprintf("%s.%s", path, fd.JSONName())
+		switch {
+		case fd.IsList():
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				if err = validateMessage(fmt.Sprintf("%s[%d]", p, i), l.Get(i).Message()); err != nil {
+					return false
+				}
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() != protoreflect.MessageKind {
+				return true
+			}
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				err = validateMessage(fmt.Sprintf("%s[%s]", p, k), v.Message())
+				return err == nil
+			})
+		default:
+			err = validateMessage(p, v.Message())
+		}
+		return err == nil
+	})
+	return err
+}
+
+func validateSelf(m proto.Message) error {
+	switch m := m.(type) {
+	case *Value:
+		switch m.GetValue().(type) {
+		case *Value_Format:
+			_, err := ParseFormat(m.GetFormat())
+			return err
+		case *Value_Expr:
+			_, err := expr.Parse(m.GetExpr())
+			return err
+		}
+	case *Template:
+		switch m.GetType() {
+		case Template_SET, Template_DELETE, Template_RENAME:
+			if len(m.GetKeys()) == 0 {
+				return errors.Newf(errors.InvalidSettings, "%s template without keys", m.GetType())
+			}
+		}
+		if m.GetType() == Template_RENAME && len(m.GetTo()) == 0 {
+			return errors.New(errors.InvalidSettings, "RENAME template without to")
+		}
+	case *Retry:
+		if m.GetMaxAttempts() < 0 {
+			return errors.Newf(errors.InvalidSettings, "invalid maxAttempts %d", m.GetMaxAttempts())
+		}
+		if x := m.GetJitter(); x < 0 || x > 1 {
+			return errors.Newf(errors.InvalidSettings, "invalid jitter %f", x)
+		}
+		for _, x := range m.GetStatuses() {
+			if !util.IsHTTPStatus(int(x)) {
+				return errors.Newf(errors.InvalidSettings, "invalid retry status %d", x)
+			}
+		}
+	case *Upstreams:
+		if len(m.GetTargets()) == 0 {
+			return errors.New(errors.InvalidSettings, "no upstream targets")
+		}
+		for _, t := range m.GetTargets() {
+			if _, err := url.Parse(t.GetUrl()); err != nil || t.GetUrl() == "" {
+				return errors.Newf(errors.InvalidSettings, "invalid upstream url %q", t.GetUrl())
+			}
+			if t.GetWeight() < 0 {
+				return errors.Newf(errors.InvalidSettings, "invalid upstream weight %d", t.GetWeight())
+			}
+		}
+	case *Mirror:
+		for _, x := range m.GetUrls() {
+			if u, err := url.Parse(x); err != nil || u.Host == "" {
+				return errors.Newf(errors.InvalidSettings, "invalid mirror url %q", x)
+			}
+		}
+	case *Cache:
+		if m.GetTtl() < 0 {
+			return errors.Newf(errors.InvalidSettings, "invalid cache ttl %d", m.GetTtl())
+		}
+		if m.GetSize() < 0 {
+			return errors.Newf(errors.InvalidSettings, "invalid cache size %d", m.GetSize())
+		}
+		for _, x := range m.GetStatuses() {
+			if !util.IsHTTPStatus(int(x)) {
+				return errors.Newf(errors.InvalidSettings, "invalid cache status %d", x)
+			}
+		}
+	case *ErrorStatus:
+		for _, x := range []int32{m.GetTimeout(), m.GetConnection(), m.GetDns(), m.GetOther()} {
+			if x != 0 && !util.IsHTTPStatus(int(x)) {
+				return errors.Newf(errors.InvalidSettings, "invalid error status %d", x)
+			}
+		}
+	case *Har:
+		if m.GetFile() == "" {
+			return errors.New(errors.InvalidSettings, "har requires file")
+		}
+	case *Record:
+		if m.GetFile() == "" {
+			return errors.New(errors.InvalidSettings, "record requires file")
+		}
+	case *Validation:
+		if x := int(m.GetStatus()); x != 0 && !util.IsHTTPStatus(x) {
+			return errors.Newf(errors.InvalidSettings, "invalid validation status %d", x)
+		}
+	case *Schema:
+		if m.GetSchema() == nil {
+			return errors.New(errors.InvalidSettings, "schema requires inline or file")
+		}
+	case *OpenAPIOperation:
+		if m.GetFile() == "" {
+			return errors.New(errors.InvalidSettings, "openapi requires file")
+		}
+	case *CircuitBreaker:
+		if x := m.GetFailureRate(); x <= 0 || x > 1 {
+			return errors.Newf(errors.InvalidSettings, "invalid failureRate %f", x)
+		}
+	case *Server:
+		return validateBreakerNames(m)
+	case *Action_Aggregate:
+		return validateAggregate(m)
+	case *Action_Return:
+		if x := int(m.GetStatus()); x != 0 && !util.IsHTTPStatus(x) {
+			return errors.Newf(errors.InvalidSettings, "invalid status %d", x)
+		}
+	}
+	return nil
+}
+
+// validateBreakerNames checks that the names of the circuit breakers are unique and not reserved.
+func validateBreakerNames(m *Server) error {
+	names := map[string]bool{}
+	check := func(gw *Action_Gateway) error {
+		name := gw.GetCircuitBreaker().GetName()
+		if name == "" {
+			return nil
+		}
+		if strings.HasPrefix(name, "#") {
+			return errors.Newf(errors.InvalidSettings, "circuit breaker name %s starts with #", name)
+		}
+		if names[name] {
+			return errors.Newf(errors.InvalidSettings, "duplicated circuit breaker %s", name)
+		}
+		names[name] = true
+		return nil
+	}
+	for _, h := range m.GetHandlers() {
+		if err := check(h.GetAction().GetGateway()); err != nil {
+			return err
+		}
+		for _, c := range h.GetAction().GetAggregate().GetCalls() {
+			if err := check(c.GetGateway()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateAggregate checks the names and the dependencies of the calls.
+func validateAggregate(m *Action_Aggregate) error {
+	deps := map[string][]string{}
+	for _, c := range m.GetCalls() {
+		if c.GetName() == "" {
+			return errors.New(errors.InvalidSettings, "aggregate call without name")
+		}
+		if _, ok := deps[c.GetName()]; ok {
+			return errors.Newf(errors.InvalidSettings, "duplicated aggregate call %s", c.GetName())
+		}
+		deps[c.GetName()] = c.GetAfter()
+	}
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return errors.Newf(errors.InvalidSettings, "cyclic aggregate call %s", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, x := range deps[name] {
+			if _, ok := deps[x]; !ok {
+				return errors.Newf(errors.InvalidSettings, "aggregate call %s after unknown %s", name, x)
+			}
+			if err := visit(x); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, c := range m.GetCalls() {
+		if err := visit(c.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/berquerant/jsonhttp/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []*struct {
+		title  string
+		config string
+		isErr  bool
+	}{
+		{
+			title:  "empty",
+			config: `{}`,
+		},
+		{
+			title: "valid",
+			config: `{"handlers":[{"action":{"return":{"templates":[{"value":{"m":{"values":{
+"f":{"format":"${query.id}"},
+"e":{"l":{"values":[{"expr":"1 + 2"}]}}
+}}}}]}}}]}`,
+		},
+		{
+			title:  "invalid format in gateway",
+			config: `{"handlers":[{"action":{"gateway":{"path":{"format":"${query.id"}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title: "invalid expr in map",
+			config: `{"handlers":[{"action":{"return":{"templates":[{"value":{"m":{"values":{
+"e":{"expr":"1 +"}
+}}}}]}}}]}`,
+			isErr: true,
+		},
+		{
+			title:  "set without keys",
+			config: `{"handlers":[{"action":{"return":{"templates":[{"type":"SET","value":{"n":1}}]}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "rename without to",
+			config: `{"handlers":[{"action":{"gateway":{"responseTemplates":[{"type":"RENAME","keys":["a"]}]}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "invalid retry jitter",
+			config: `{"handlers":[{"action":{"gateway":{"retry":{"maxAttempts":3,"jitter":1.5}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "invalid retry status",
+			config: `{"handlers":[{"action":{"gateway":{"retry":{"maxAttempts":3,"statuses":[5030]}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "no upstream targets",
+			config: `{"handlers":[{"action":{"gateway":{"upstreams":{}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "empty upstream url",
+			config: `{"handlers":[{"action":{"gateway":{"upstreams":{"targets":[{"url":"http://127.0.0.1:10000"},{"weight":1}]}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "no failure rate",
+			config: `{"handlers":[{"action":{"gateway":{"circuitBreaker":{"minRequests":10}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title: "named circuit breakers",
+			config: `{"handlers":[
+{"action":{"gateway":{"circuitBreaker":{"name":"a","failureRate":0.5}}}},
+{"action":{"gateway":{"circuitBreaker":{"failureRate":0.5}}}},
+{"action":{"aggregate":{"calls":[{"name":"x","gateway":{"circuitBreaker":{"name":"b","failureRate":0.5}}}]}}}
+]}`,
+		},
+		{
+			title: "duplicated circuit breaker",
+			config: `{"handlers":[
+{"action":{"gateway":{"circuitBreaker":{"name":"a","failureRate":0.5}}}},
+{"action":{"aggregate":{"calls":[{"name":"x","gateway":{"circuitBreaker":{"name":"a","failureRate":0.5}}}]}}}
+]}`,
+			isErr: true,
+		},
+		{
+			title:  "reserved circuit breaker name",
+			config: `{"handlers":[{"action":{"gateway":{"circuitBreaker":{"name":"#0","failureRate":0.5}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "invalid status in fallback",
+			config: `{"handlers":[{"action":{"gateway":{"circuitBreaker":{"failureRate":0.5,"fallback":{"status":99}}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title: "aggregate",
+			config: `{"handlers":[{"action":{"aggregate":{"calls":[
+{"name":"a"},{"name":"b","after":["a"]},{"name":"c","after":["a","b"]}
+]}}}]}`,
+		},
+		{
+			title:  "aggregate duplicated call",
+			config: `{"handlers":[{"action":{"aggregate":{"calls":[{"name":"a"},{"name":"a"}]}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "aggregate after unknown call",
+			config: `{"handlers":[{"action":{"aggregate":{"calls":[{"name":"a","after":["b"]}]}}}]}`,
+			isErr:  true,
+		},
+		{
+			title: "aggregate cyclic calls",
+			config: `{"handlers":[{"action":{"aggregate":{"calls":[
+{"name":"a","after":["c"]},{"name":"b","after":["a"]},{"name":"c","after":["b"]}
+]}}}]}`,
+			isErr: true,
+		},
+		{
+			title:  "invalid mirror url",
+			config: `{"handlers":[{"action":{"gateway":{"mirror":{"urls":["127.0.0.1:10001"]}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "invalid cache ttl",
+			config: `{"handlers":[{"action":{"gateway":{"cache":{"ttl":-1}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "invalid cache status",
+			config: `{"handlers":[{"action":{"gateway":{"cache":{"statuses":[99]}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "invalid error status",
+			config: `{"handlers":[{"action":{"gateway":{"errorStatus":{"timeout":600}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "record without file",
+			config: `{"handlers":[{"action":{"gateway":{"record":{"headers":["Accept"]}}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "har without file",
+			config: `{"hars":[{"matchBody":true}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "validation",
+			config: `{"handlers":[{"validate":{"body":{"inline":{"type":"object"}},"query":{"file":"query.json"},"status":400}}]}`,
+		},
+		{
+			title:  "invalid validation status",
+			config: `{"handlers":[{"validate":{"status":99}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "empty schema",
+			config: `{"handlers":[{"validate":{"body":{}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "openapi without file",
+			config: `{"handlers":[{"validate":{"openapi":{"path":"/users"}}}]}`,
+			isErr:  true,
+		},
+		{
+			title:  "invalid status",
+			config: `{"handlers":[{"action":{"return":{"status":1000}}}]}`,
+			isErr:  true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var v pb.Server
+			assert.Nil(t, protojson.Unmarshal([]byte(tc.config), &v))
+			err := pb.Validate(&v)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -1,11 +1,17 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
 
 	"github.com/berquerant/jsonhttp/handler"
+	"github.com/berquerant/jsonhttp/internal/errors"
+	"github.com/berquerant/jsonhttp/internal/har"
 	"github.com/berquerant/jsonhttp/internal/logger"
 	"github.com/berquerant/jsonhttp/internal/util"
 	"github.com/berquerant/jsonhttp/pb"
@@ -27,47 +33,201 @@ type Server struct {
 	server *http.Server
 }
 
-func (s *Server) serveMux() *http.ServeMux {
+func (s *Server) serveMux() (*http.ServeMux, error) {
 	mux := http.NewServeMux()
-	mux.Handle("/checkalive", handler.CheckAlive())
-	for _, x := range s.value.GetHandlers() {
-		h, ok := handler.HandlerFromAction(x.GetAction())
-		if !ok {
-			s.logger.Warn("cannot handle %s", util.JSON(x))
+	client, err := handler.NewHTTPClient(s.value.GetClient())
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InvalidSettings, "server client")
+	}
+	env := handler.NewEnv(client)
+	type entry struct {
+		x *pb.Handler
+		h http.Handler
+		// true if the path has path parameters
+		isPattern bool
+	}
+	var (
+		// patterns of ServeMux
+		patterns []string
+		entries  = map[string][]*entry{}
+	)
+	handlers := s.value.GetHandlers()
+	for _, x := range s.value.GetHars() {
+		hs, err := s.harHandlers(x)
+		if err != nil {
+			s.logger.Warn("cannot load har %s %v", x.GetFile(), err)
 			continue
 		}
+		s.logger.Info("load %d handlers from har %s", len(hs), x.GetFile())
+		handlers = append(handlers, hs...)
+	}
+	for _, x := range handlers {
+		h, err := handler.HandlerFromAction(x.GetAction(), env)
+		if err != nil {
+			s.logger.Warn("cannot handle %s %v", util.JSON(x), err)
+			continue
+		}
+		if v := x.GetValidate(); v != nil {
+			validator, err := handler.NewRequestValidator(x)
+			if err != nil {
+				s.logger.Warn("cannot validate %s %v", util.JSON(x), err)
+				continue
+			}
+			h = handler.ValidateHandler(v, validator, h)
+		}
 		s.logger.Info("handle %s", util.JSON(x))
-		func(x *pb.Handler) {
-			mux.HandleFunc(x.GetPath(), func(w http.ResponseWriter, r *http.Request) {
-				s.logger.Debug(`%s %d "%s %s" "%s" for %s`,
-					r.RemoteAddr,
-					r.ContentLength,
-					r.Method,
-					r.URL,
-					r.UserAgent(),
-					util.JSON(x),
+		pattern, isPattern := util.PathPatternPrefix(x.GetPath())
+		if _, ok := entries[pattern]; !ok {
+			patterns = append(patterns, pattern)
+		}
+		entries[pattern] = append(entries[pattern], &entry{
+			x:         x,
+			h:         h,
+			isPattern: isPattern,
+		})
+	}
+	// the handlers of the config take precedence over the builtin handlers
+	builtins := map[string]http.Handler{
+		"/checkalive": handler.CheckAlive(),
+	}
+	if len(env.Breakers()) > 0 {
+		builtins["/admin/breakers"] = handler.AdminBreakers(env)
+	}
+	for pattern, h := range builtins {
+		if _, ok := entries[pattern]; ok {
+			s.logger.Warn("%s is overridden by the handlers", pattern)
+			continue
+		}
+		mux.Handle(pattern, h)
+	}
+	matcher := pb.NewRequestMatcher(pb.NewValueInverter())
+	for _, pattern := range patterns {
+		func(es []*entry) {
+			mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					s.logger.Warn("cannot read body %v", err)
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+				type candidate struct {
+					e      *entry
+					params map[string]string
+				}
+				var (
+					pathMatched   bool
+					methodAllowed bool
+					// the closest handler if no handler is matched
+					closest      *candidate
+					closestScore = -1
 				)
-				if r.Method != x.GetMethodType().String() {
+				serve := func(x *candidate) {
+					e, r := x.e, r
+					if e.isPattern {
+						r = r.WithContext(handler.WithPathParams(r.Context(), x.params))
+					}
+					s.logger.Debug(`%s %d "%s %s" "%s" for %s`,
+						r.RemoteAddr,
+						r.ContentLength,
+						r.Method,
+						r.URL,
+						r.UserAgent(),
+						util.JSON(e.x),
+					)
+					e.h.ServeHTTP(w, r)
+				}
+				for _, e := range es {
+					var params map[string]string
+					if e.isPattern {
+						var ok bool
+						if params, ok = util.MatchPathPattern(e.x.GetPath(), r.URL.Path); !ok {
+							continue
+						}
+					}
+					pathMatched = true
+					if e.x.GetMethodType() != pb.MethodType_ANY && r.Method != e.x.GetMethodType().String() {
+						continue
+					}
+					methodAllowed = true
+					ok, err := matcher.Match(e.x.GetMatch(), r, body)
+					if err != nil {
+						s.logger.Warn("cannot match %s %v", util.JSON(e.x), err)
+						continue
+					}
+					if ok {
+						serve(&candidate{
+							e:      e,
+							params: params,
+						})
+						return
+					}
+					if !e.x.GetMatch().GetClosest() {
+						continue
+					}
+					if score, err := matcher.Score(e.x.GetMatch(), r, body); err == nil && score > closestScore {
+						closest, closestScore = &candidate{
+							e:      e,
+							params: params,
+						}, score
+					}
+				}
+				if closest != nil {
+					serve(closest)
+					return
+				}
+				if pathMatched && !methodAllowed {
 					w.WriteHeader(http.StatusMethodNotAllowed)
 					return
 				}
-				h.ServeHTTP(w, r)
+				w.WriteHeader(http.StatusNotFound)
 			})
-		}(x)
+		}(entries[pattern])
+	}
+	return mux, nil
+}
+
+// harHandlers returns the handlers replaying the entries of the HAR archive, skips unsupported entries.
+func (s *Server) harHandlers(h *pb.Har) ([]*pb.Handler, error) {
+	b, err := os.ReadFile(h.GetFile())
+	if err != nil {
+		return nil, err
+	}
+	archive, err := har.Parse(b)
+	if err != nil {
+		return nil, err
 	}
-	return mux
+	builder := pb.NewHARBuilder(h, func(r *pb.Record) pb.RecordBuilder {
+		return pb.NewRecordBuilder(r, pb.NewValueConverter())
+	})
+	var handlers []*pb.Handler
+	for i, e := range archive.Log.Entries {
+		x, err := builder.Build(e)
+		if err != nil {
+			s.logger.Warn("skip har entry %d of %s %v", i, h.GetFile(), err)
+			continue
+		}
+		handlers = append(handlers, x)
+	}
+	return handlers, nil
 }
 
-// Start starts listening.
-func (s *Server) Start() {
+// Start starts listening and blocks until this server is closed,
+// returns an error if the settings are invalid or the server cannot listen.
+func (s *Server) Start() error {
+	mux, err := s.serveMux()
+	if err != nil {
+		return err
+	}
 	s.logger.Info("listening on %d", s.value.GetPort())
 	s.server = &http.Server{
 		Addr:    fmt.Sprintf("localhost:%d", s.value.GetPort()),
-		Handler: s.serveMux(),
+		Handler: mux,
+	}
+	if err := s.server.ListenAndServe(); !stderrors.Is(err, http.ErrServerClosed) {
+		return err
 	}
-	err := s.server.ListenAndServe()
 	<-s.closeC
-	s.logger.Info("shutdown %v", err)
+	s.logger.Info("shutdown")
+	return nil
 }
 
 // Close closes this server.